@@ -0,0 +1,51 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"unicode"
+)
+
+// The Unicode directional isolates: FSI starts a run of text whose direction
+// is taken from its first strong character, isolated from the surrounding
+// text, until the PDI.
+const (
+	firstStrongIsolate    = "\u2068"
+	popDirectionalIsolate = "\u2069"
+)
+
+// rtlTable holds the blocks of the right-to-left scripts, such as Hebrew,
+// Arabic, Syriac and Thaana.
+var rtlTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0590, Hi: 0x08ff, Stride: 1},
+		{Lo: 0xfb1d, Hi: 0xfdff, Stride: 1},
+		{Lo: 0xfe70, Hi: 0xfeff, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x10800, Hi: 0x10fff, Stride: 1},
+		{Lo: 0x1e800, Hi: 0x1efff, Stride: 1},
+	},
+}
+
+// isRTLDominant reports whether more of the letters in s are from
+// right-to-left scripts than from left-to-right ones.
+func isRTLDominant(s string) bool {
+	rtl, ltr := 0, 0
+	for _, r := range stripEscapes(s) {
+		switch {
+		case !unicode.IsLetter(r):
+		case unicode.Is(rtlTable, r):
+			rtl++
+		default:
+			ltr++
+		}
+	}
+	return rtl > ltr
+}
+
+// isolateBidi wraps text in directional isolates, so that the terminal
+// reorders any right-to-left text within it without affecting the borders.
+func isolateBidi(text string) string {
+	return firstStrongIsolate + text + popDirectionalIsolate
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func TestIsRTLDominant(t *testing.T) {
+	tests := []struct {
+		in  string
+		rtl bool
+	}{
+		{"hello", false},
+		{"שלום", true},
+		{"مرحبا", true},
+		{"שלום world", false},
+		{"שלום עולם hi", true},
+		{"123", false},
+		{"\033[1mשלום\033[0m", true},
+	}
+	for _, test := range tests {
+		if got := isRTLDominant(test.in); got != test.rtl {
+			t.Errorf("Unexpected result for %q; expected %v but got %v", test.in, test.rtl, got)
+		}
+	}
+}
+
+func createBidiTable() *Table {
+	table := CreateTable()
+	table.Style = &TableStyle{
+		BorderX: "-", BorderY: "|", BorderI: "+",
+		PaddingLeft: 1, PaddingRight: 1,
+		Alignment:     AlignLeft,
+		IsolateBidi:   true,
+		RightAlignRTL: true,
+	}
+	table.AddHeaders("Language", "Greeting")
+	table.AddRow("English", "hello")
+	table.AddRow("Hebrew", "שלום")
+	table.AddRow("Arabic", "مرحبا 123")
+	return table
+}
+
+func TestTableBidi(t *testing.T) {
+	fsi, pdi := "\u2068", "\u2069"
+	expected := "" +
+		"+----------+-----------+\n" +
+		"| " + fsi + "Language" + pdi + " | " + fsi + "Greeting" + pdi + "  |\n" +
+		"+----------+-----------+\n" +
+		"| " + fsi + "English" + pdi + "  | " + fsi + "hello" + pdi + "     |\n" +
+		"| " + fsi + "Hebrew" + pdi + "   |      " + fsi + "שלום" + pdi + " |\n" +
+		"| " + fsi + "Arabic" + pdi + "   | " + fsi + "مرحبا 123" + pdi + " |\n" +
+		"+----------+-----------+\n"
+
+	checkRendersTo(t, createBidiTable(), expected)
+}
+
+func TestTableBidiHTML(t *testing.T) {
+	expected := "<table class=\"termtable\">\n" +
+		"<thead>\n" +
+		"<tr><th dir='auto'>Language</th><th dir='auto'>Greeting</th></tr>\n" +
+		"</thead>\n" +
+		"<tbody>\n" +
+		"<tr><td dir='auto'>English</td><td dir='auto'>hello</td></tr>\n" +
+		"<tr><td dir='auto'>Hebrew</td><td dir='auto'>שלום</td></tr>\n" +
+		"<tr><td dir='auto'>Arabic</td><td dir='auto'>مرحبا 123</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+
+	table := createBidiTable()
+	table.SetModeHTML()
+	checkRendersTo(t, table, expected)
+}
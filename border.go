@@ -0,0 +1,91 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"fmt"
+)
+
+// A BorderStyle holds the characters for drawing the borders of a table, as
+// used by the Border fields of TableStyle; those left empty default to I.
+// None draws no borders at all, setting SkipVerticalBorders and
+// SkipHorizontalBorders.
+type BorderStyle struct {
+	X, Y, I                                    string
+	Top, Bottom, Left, Right                   string
+	TopLeft, TopRight, BottomLeft, BottomRight string
+	None                                       bool
+}
+
+// asciiBorders and roundedBorders are the borders of DefaultStyle and of
+// UTF8Box, and those used for Markdown; they are kept apart from
+// BorderStyles so that changes to the catalogue cannot affect them.
+var (
+	asciiBorders   = BorderStyle{X: "-", Y: "|", I: "+"}
+	roundedBorders = BorderStyle{
+		X: "─", Y: "│", I: "┼",
+		Top: "┬", Bottom: "┴", Left: "├", Right: "┤",
+		TopLeft: "╭", TopRight: "╮", BottomLeft: "╰", BottomRight: "╯",
+	}
+)
+
+// BorderStyles is the catalogue of border styles which SetBorderStyle
+// selects from by name; entries can be added or changed, without affecting
+// UTF8Box or Markdown output.
+var BorderStyles = map[string]BorderStyle{
+	"ascii":   asciiBorders,
+	"rounded": roundedBorders,
+	"light": {
+		X: "─", Y: "│", I: "┼",
+		Top: "┬", Bottom: "┴", Left: "├", Right: "┤",
+		TopLeft: "┌", TopRight: "┐", BottomLeft: "└", BottomRight: "┘",
+	},
+	"heavy": {
+		X: "━", Y: "┃", I: "╋",
+		Top: "┳", Bottom: "┻", Left: "┣", Right: "┫",
+		TopLeft: "┏", TopRight: "┓", BottomLeft: "┗", BottomRight: "┛",
+	},
+	"double": {
+		X: "═", Y: "║", I: "╬",
+		Top: "╦", Bottom: "╩", Left: "╠", Right: "╣",
+		TopLeft: "╔", TopRight: "╗", BottomLeft: "╚", BottomRight: "╝",
+	},
+	"dashed": {
+		X: "╌", Y: "╎", I: "┼",
+		Top: "┬", Bottom: "┴", Left: "├", Right: "┤",
+		TopLeft: "┌", TopRight: "┐", BottomLeft: "└", BottomRight: "┘",
+	},
+	"markdown": {X: "-", Y: "|", I: "|"},
+	"dots": {
+		X: ".", Y: ":", I: ":",
+		Top: ".", TopLeft: ".", TopRight: ".",
+	},
+	"block": {X: "█", Y: "█", I: "█"},
+	"none":  {None: true},
+}
+
+// SetBorderStyle sets the characters used to draw the borders of the table
+// to those of a style in BorderStyles, by name, such as "double"; it returns
+// an error, leaving the table unchanged, if there is no such style.  The
+// table gets its own copy of its TableStyle, so other tables are unaffected.
+func (t *Table) SetBorderStyle(name string) error {
+	b, ok := BorderStyles[name]
+	if !ok {
+		return fmt.Errorf("termtables: unknown border style %q", name)
+	}
+	style := *t.Style
+	style.setBorders(b)
+	style.SkipVerticalBorders = b.None
+	style.SkipHorizontalBorders = b.None
+	t.Style = &style
+	return nil
+}
+
+// setBorders changes the border characters to those of a BorderStyle.
+func (s *TableStyle) setBorders(b BorderStyle) {
+	s.BorderX, s.BorderY, s.BorderI = b.X, b.Y, b.I
+	s.BorderTop, s.BorderBottom, s.BorderLeft, s.BorderRight = b.Top, b.Bottom, b.Left, b.Right
+	s.BorderTopLeft, s.BorderTopRight = b.TopLeft, b.TopRight
+	s.BorderBottomLeft, s.BorderBottomRight = b.BottomLeft, b.BottomRight
+	s.fillStyleRules()
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestTableBorderStyles(t *testing.T) {
+	names := []string{}
+	for name := range BorderStyles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		table := createFooterTable()
+		narrowAmbiguous(table)
+		table.AddTitle("Hosts")
+		table.AddFooter("Total", AggregateSum, AggregateMax)
+		if err := table.SetBorderStyle(name); err != nil {
+			t.Fatal(err)
+		}
+		output := table.Render()
+
+		golden := filepath.Join("testdata", "border_"+name+".golden")
+		if *updateGolden {
+			if err := ioutil.WriteFile(golden, []byte(output), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		expected, err := ioutil.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if output != string(expected) {
+			t.Errorf("Border style %q:\n%s", name, DisplayFailedOutput(output, string(expected)))
+		}
+	}
+}
+
+func TestTableSetBorderStyle(t *testing.T) {
+	table := CreateTable()
+	style := table.Style
+	if err := table.SetBorderStyle("double"); err != nil {
+		t.Fatal(err)
+	}
+	if table.Style == style || style.BorderX != "-" {
+		t.Fatal("SetBorderStyle changed a shared TableStyle")
+	}
+	if err := table.SetBorderStyle("wavy"); err == nil {
+		t.Fatal("SetBorderStyle accepted an unknown style")
+	}
+	if table.Style.BorderX != "═" {
+		t.Fatalf("An unknown style changed the table; BorderX is %q", table.Style.BorderX)
+	}
+}
+
+func TestTableBorderStylesCatalogueIsolated(t *testing.T) {
+	defer func(ascii, rounded BorderStyle) {
+		BorderStyles["ascii"], BorderStyles["rounded"] = ascii, rounded
+	}(BorderStyles["ascii"], BorderStyles["rounded"])
+	BorderStyles["ascii"] = BorderStyle{X: "=", Y: "!", I: "#"}
+	delete(BorderStyles, "rounded")
+
+	expected := "" +
+		"| Host | Up |\n" +
+		"| ---- | -- |\n" +
+		"| web1 | 1  |\n"
+
+	table := CreateTable()
+	table.SetModeMarkdown()
+	table.AddHeaders("Host", "Up")
+	table.AddRow("web1", 1)
+	checkRendersTo(t, table, expected)
+
+	expected = "" +
+		"╭──────┬────╮\n" +
+		"│ Host │ Up │\n" +
+		"├──────┼────┤\n" +
+		"│ web1 │ 1  │\n" +
+		"╰──────┴────╯\n"
+
+	table.SetModeTerminal()
+	table.Style = &TableStyle{PaddingLeft: 1, PaddingRight: 1, Alignment: AlignLeft, AmbiguousWidth: AmbiguousNarrow}
+	table.UTF8Box()
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableBorderStyleAfterMarkdown(t *testing.T) {
+	expected := "" +
+		"╔══════╦════╗\n" +
+		"║ Host ║ Up ║\n" +
+		"╠══════╬════╣\n" +
+		"║ web1 ║ 1  ║\n" +
+		"╚══════╩════╝\n"
+
+	defaultX := DefaultStyle.BorderX
+	table := CreateTable()
+	narrowAmbiguous(table)
+	table.AddHeaders("Host", "Up")
+	table.AddRow("web1", 1)
+	if err := table.SetBorderStyle("double"); err != nil {
+		t.Fatal(err)
+	}
+	table.SetModeMarkdown()
+	table.Render()
+	table.SetModeTerminal()
+	checkRendersTo(t, table, expected)
+
+	table = CreateTable()
+	table.UTF8Box()
+	table.SetModeMarkdown()
+	table.AddRow("web1", 1)
+	table.Render()
+	if table.Style.BorderX != "─" || DefaultStyle.BorderX != defaultX {
+		t.Errorf("Rendering Markdown changed a TableStyle; BorderX is %q and DefaultStyle.BorderX is %q",
+			table.Style.BorderX, DefaultStyle.BorderX)
+	}
+}
@@ -3,40 +3,28 @@
 package termtables
 
 import (
-	"fmt"
 	"math"
-	"regexp"
-	"strconv"
 	"strings"
-	"unicode/utf8"
 
 	runewidth "github.com/mattn/go-runewidth"
 )
 
-var (
-	// Must match SGR escape sequence, which is "CSI Pm m", where the Control
-	// Sequence Introducer (CSI) is "ESC ["; where Pm is "A multiple numeric
-	// parameter composed of any number of single numeric parameters, separated
-	// by ; character(s).  Individual values for the parameters are listed with
-	// Ps" and where Ps is A single (usually optional) numeric parameter,
-	// composed of one of [sic] more digits."
-	//
-	// In practice, the end sequence is usually given as \e[0m but reading that
-	// definition, it's clear that the 0 is optional and some testing confirms
-	// that it is certainly optional with MacOS Terminal 2.3, so we need to
-	// support the string \e[m as a terminator too.
-	colorFilter = regexp.MustCompile(`\033\[(?:\d+(?:;\d+)*)?m`)
-)
-
 // A Cell denotes one cell of a table; it spans one row and a variable number
 // of columns.  A given Cell can only be used at one place in a table; the act
 // of adding the Cell to the table mutates it with position information, so
 // do not create one "const" Cell to add it multiple times.
 type Cell struct {
 	column         int
+	value          interface{}
 	formattedValue string
 	alignment      *tableAlignment
 	colSpan        int
+	textStyle      TextStyle
+	link           string
+	format         FormatFunc
+
+	// computed is set for cells holding an aggregate of their column
+	computed bool
 }
 
 // CreateCell returns a Cell where the content is the supplied value, with the
@@ -49,9 +37,17 @@ func CreateCell(v interface{}, style *CellStyle) *Cell {
 }
 
 func createCell(column int, v interface{}, style *CellStyle) *Cell {
-	cell := &Cell{column: column, formattedValue: renderValue(v), colSpan: 1}
+	cell := &Cell{column: column, value: v, formattedValue: renderValue(v), colSpan: 1}
+	if link, ok := v.(Hyperlink); ok {
+		cell.link = link.URL
+	}
 	if style != nil {
 		cell.alignment = &style.Alignment
+		cell.textStyle = style.TextStyle
+		if style.Format != nil {
+			cell.format = style.Format
+			cell.formattedValue = style.Format(v)
+		}
 		if style.ColSpan != 0 {
 			cell.colSpan = style.ColSpan
 		}
@@ -59,31 +55,58 @@ func createCell(column int, v interface{}, style *CellStyle) *Cell {
 	return cell
 }
 
+// Value returns the value which the cell was created with, before any
+// formatting.
+func (c *Cell) Value() interface{} {
+	return c.value
+}
+
 // Width returns the width of the content of the cell, measured in runes as best
-// as possible considering sophisticated Unicode.
+// as possible considering sophisticated Unicode.  East Asian ambiguous-width
+// characters are measured according to the current locale.
 func (c *Cell) Width() int {
-	return runewidth.StringWidth(filterColorCodes(c.formattedValue))
+	return textWidth(AmbiguousAuto.condition(), c.formattedValue)
 }
 
-// Filter out terminal bold/color sequences in a string.
-// This supports only basic bold/color escape sequences.
+// width returns the width of the content of the cell as it will be drawn
+// with the supplied style in the given row, which can differ from Width for,
+// eg, Markdown links.
+func (c *Cell) width(style *renderStyle, r *Row) int {
+	return style.textWidth(c.content(style, r))
+}
+
+// textWidth returns the number of tty character-cells needed to draw s.
+func textWidth(cond *runewidth.Condition, s string) int {
+	return stringWidth(cond, filterColorCodes(s))
+}
+
+// Filter out terminal bold/color sequences, hyperlinks and all other escape
+// sequences in a string.
 func filterColorCodes(s string) string {
-	return colorFilter.ReplaceAllString(s, "")
+	return stripEscapes(s)
 }
 
 // Render returns a string representing the content of the cell, together with
 // padding (to the widths specified) and handling any alignment.
-func (c *Cell) Render(style *renderStyle) (buffer string) {
+func (c *Cell) Render(style *renderStyle) string {
+	return c.render(style, nil)
+}
+
+// render is Render with the row which the cell is in supplied, as that and
+// the column settings affect how the content is drawn; the row is nil for
+// cells drawn outside of any row, such as titles in Markdown.
+func (c *Cell) render(style *renderStyle, r *Row) (buffer string) {
 	// if no alignment is set, import the table's default
 	if c.alignment == nil {
-		c.alignment = &style.Alignment
+		align := c.effectiveAlignment(style)
+		c.alignment = &align
 	}
 
 	// left padding
 	buffer += strings.Repeat(" ", style.PaddingLeft)
 
 	// append the main value and handle alignment
-	buffer += c.alignCell(style)
+	buffer += c.alignCell(style, r, c.content(style, r))
 
 	// right padding
 	buffer += strings.Repeat(" ", style.PaddingRight)
@@ -97,9 +120,45 @@ func (c *Cell) Render(style *renderStyle) (buffer string) {
 	return buffer
 }
 
-func (c *Cell) alignCell(style *renderStyle) string {
+// effectiveAlignment returns the alignment with which the cell is drawn:
+// its own, if set, or else the table's default.
+func (c *Cell) effectiveAlignment(style *renderStyle) tableAlignment {
+	if c.alignment != nil {
+		return *c.alignment
+	}
+	if style.RightAlignRTL && isRTLDominant(c.formattedValue) {
+		return AlignRight
+	}
+	return style.Alignment
+}
+
+// content returns the value of the cell as it is to be drawn with the
+// supplied style in the given row, but without padding.
+func (c *Cell) content(style *renderStyle, r *Row) string {
+	content := style.cellText(r, c)
+	if r != nil && r.upperCase {
+		content = strings.ToUpper(content)
+	}
+	if !style.sanitize.IsZero() {
+		content = style.sanitize.sanitize(content, style)
+	}
+	if style.stripsColor() {
+		content = filterColorCodes(content)
+	}
+	content = style.applyTextStyle(content, style.textStyle(r, c))
+	if style.IsolateBidi && style.outputMode == outputTerminal {
+		content = isolateBidi(content)
+	}
+	if c.link != "" {
+		content = style.applyLink(content, c.link)
+	}
+	return content
+}
+
+func (c *Cell) alignCell(style *renderStyle, r *Row, content string) string {
 	buffer := ""
 	width := style.CellWidth(c.column)
+	contentWidth := style.textWidth(content)
 
 	if c.colSpan > 1 {
 		for i := 1; i < c.colSpan; i++ {
@@ -107,62 +166,59 @@ func (c *Cell) alignCell(style *renderStyle) string {
 			if w == 0 {
 				break
 			}
-			width += style.PaddingLeft + w + style.PaddingRight + utf8.RuneCountInString(style.BorderY)
+			width += style.PaddingLeft + w + style.PaddingRight + style.textWidth(style.cellGap())
 		}
 	}
 
 	switch *c.alignment {
 
 	default:
-		buffer += c.formattedValue
-		if l := width - c.Width(); l > 0 {
+		buffer += content
+		if l := width - contentWidth; l > 0 {
 			buffer += strings.Repeat(" ", l)
 		}
 
 	case AlignLeft:
-		buffer += c.formattedValue
-		if l := width - c.Width(); l > 0 {
+		buffer += content
+		if l := width - contentWidth; l > 0 {
 			buffer += strings.Repeat(" ", l)
 		}
 
 	case AlignRight:
-		if l := width - c.Width(); l > 0 {
+		if l := width - contentWidth; l > 0 {
 			buffer += strings.Repeat(" ", l)
 		}
-		buffer += c.formattedValue
+		buffer += content
 
 	case AlignCenter:
 		left, right := 0, 0
-		if l := width - c.Width(); l > 0 {
+		if l := width - contentWidth; l > 0 {
 			lf := float64(l)
 			left = int(math.Floor(lf / 2))
 			right = int(math.Ceil(lf / 2))
 		}
 		buffer += strings.Repeat(" ", left)
-		buffer += c.formattedValue
+		buffer += content
 		buffer += strings.Repeat(" ", right)
+
+	case AlignDecimal:
+		left, _, ok := style.decimalSplit(content, c.column)
+		pad := style.decimalWidths[c.column].left - left
+		if pad < 0 || c.colSpan > 1 || !ok || (r != nil && r.header) {
+			pad = 0
+		}
+		buffer += strings.Repeat(" ", pad)
+		buffer += content
+		if l := width - pad - contentWidth; l > 0 {
+			buffer += strings.Repeat(" ", l)
+		}
 	}
 
 	return buffer
 }
 
-// Format the raw value as a string depending on the type
+// Format the raw value as a string depending on the type, using any
+// Formatter registered for every table.
 func renderValue(v interface{}) string {
-	switch vv := v.(type) {
-	case string:
-		return vv
-	case bool:
-		return strconv.FormatBool(vv)
-	case int:
-		return strconv.Itoa(vv)
-	case int64:
-		return strconv.FormatInt(vv, 10)
-	case uint64:
-		return strconv.FormatUint(vv, 10)
-	case float64:
-		return strconv.FormatFloat(vv, 'f', 2, 64)
-	case fmt.Stringer:
-		return vv.String()
-	}
-	return fmt.Sprintf("%v", v)
+	return valueFormatter{registries: []*formatterRegistry{&defaultFormatters}}.format(v)
 }
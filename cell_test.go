@@ -102,6 +102,8 @@ func TestFilterColorCodes(t *testing.T) {
 		{"\033[31mfoo\033[0;0mbar", "foobar"},
 		{"\033[31;4mfoo\033[0mbar", "foobar"},
 		{"\033[31;4;43mfoo\033[0mbar", "foobar"},
+		{"\033]8;;http://example.com/\033\\link\033]8;;\033\\", "link"},
+		{"\033]8;id=1;http://example.com/\alink\033]8;;\a", "link"},
 	}
 	for _, test := range tests {
 		got := filterColorCodes(test.in)
@@ -0,0 +1,272 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/apcera/termtables/term"
+)
+
+// A Color is a foreground or background colour for text in a table.  The
+// zero value means "no colour", leaving the terminal default in place.  The
+// sixteen basic colours are provided as constants; Color256 and ColorRGB
+// give access to the extended palettes.
+type Color uint32
+
+type colorKind uint32
+
+const (
+	colorKindNone colorKind = iota
+	colorKindBasic
+	colorKind256
+	colorKindRGB
+)
+
+const colorKindShift = 24
+
+// These constants are the sixteen basic terminal colours; the exact shade
+// drawn is up to the terminal's palette.
+const (
+	ColorBlack Color = Color(colorKindBasic<<colorKindShift) + iota
+	ColorRed
+	ColorGreen
+	ColorYellow
+	ColorBlue
+	ColorMagenta
+	ColorCyan
+	ColorWhite
+	ColorBrightBlack
+	ColorBrightRed
+	ColorBrightGreen
+	ColorBrightYellow
+	ColorBrightBlue
+	ColorBrightMagenta
+	ColorBrightCyan
+	ColorBrightWhite
+)
+
+// Color256 returns the colour at index n of the xterm 256-colour palette.
+func Color256(n uint8) Color {
+	return Color(colorKind256<<colorKindShift) | Color(n)
+}
+
+// ColorRGB returns a 24-bit "truecolor" colour.
+func ColorRGB(r, g, b uint8) Color {
+	return Color(colorKindRGB<<colorKindShift) | Color(r)<<16 | Color(g)<<8 | Color(b)
+}
+
+func (c Color) kind() colorKind {
+	return colorKind(c >> colorKindShift)
+}
+
+func (c Color) index() uint8 {
+	return uint8(c)
+}
+
+func (c Color) rgb() (r, g, b uint8) {
+	switch c.kind() {
+	case colorKindBasic, colorKind256:
+		return paletteRGB(c.index())
+	}
+	return uint8(c >> 16), uint8(c >> 8), uint8(c)
+}
+
+// sgr returns the SGR parameters selecting this colour, either as a
+// foreground or as a background colour.
+func (c Color) sgr(background bool) string {
+	base := 30
+	if background {
+		base = 40
+	}
+	switch c.kind() {
+	case colorKindBasic:
+		n := int(c.index())
+		if n >= 8 {
+			return strconv.Itoa(base + 60 + n - 8)
+		}
+		return strconv.Itoa(base + n)
+	case colorKind256:
+		return fmt.Sprintf("%d;5;%d", base+8, c.index())
+	case colorKindRGB:
+		r, g, b := c.rgb()
+		return fmt.Sprintf("%d;2;%d;%d;%d", base+8, r, g, b)
+	}
+	return ""
+}
+
+// css returns the colour as a CSS hex colour specification.
+func (c Color) css() string {
+	r, g, b := c.rgb()
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
+
+// downgrade returns the nearest colour which can be shown with the given
+// colour depth; this is no colour at all for term.NoColor.
+func (c Color) downgrade(depth term.ColorDepth) Color {
+	switch {
+	case c == 0 || depth == term.NoColor:
+		return 0
+	case c.kind() == colorKindRGB && depth < term.TrueColor:
+		r, g, b := c.rgb()
+		c = Color256(nearestPaletteIndex(r, g, b, 16, 256))
+	}
+	if c.kind() == colorKind256 && depth < term.Colors256 {
+		if n := c.index(); n < 16 {
+			return ColorBlack + Color(n)
+		}
+		r, g, b := c.rgb()
+		c = ColorBlack + Color(nearestPaletteIndex(r, g, b, 0, 16))
+	}
+	return c
+}
+
+// nearestPaletteIndex returns the index, in the range [from, to), of the
+// 256-colour palette entry closest to the given RGB value.
+func nearestPaletteIndex(r, g, b uint8, from, to int) uint8 {
+	best, bestDistance := from, -1
+	for i := from; i < to; i++ {
+		pr, pg, pb := paletteRGB(uint8(i))
+		dr, dg, db := int(pr)-int(r), int(pg)-int(g), int(pb)-int(b)
+		if d := dr*dr + dg*dg + db*db; bestDistance < 0 || d < bestDistance {
+			best, bestDistance = i, d
+		}
+	}
+	return uint8(best)
+}
+
+// basicPalette is the xterm rendering of the sixteen basic colours.
+var basicPalette = [16][3]uint8{
+	{0x00, 0x00, 0x00}, {0xcd, 0x00, 0x00}, {0x00, 0xcd, 0x00}, {0xcd, 0xcd, 0x00},
+	{0x00, 0x00, 0xee}, {0xcd, 0x00, 0xcd}, {0x00, 0xcd, 0xcd}, {0xe5, 0xe5, 0xe5},
+	{0x7f, 0x7f, 0x7f}, {0xff, 0x00, 0x00}, {0x00, 0xff, 0x00}, {0xff, 0xff, 0x00},
+	{0x5c, 0x5c, 0xff}, {0xff, 0x00, 0xff}, {0x00, 0xff, 0xff}, {0xff, 0xff, 0xff},
+}
+
+// cubeLevels are the intensities used by the 6x6x6 colour cube which makes
+// up indices 16-231 of the 256-colour palette.
+var cubeLevels = [6]uint8{0x00, 0x5f, 0x87, 0xaf, 0xd7, 0xff}
+
+// paletteRGB returns the xterm RGB value for an index in the 256-colour
+// palette; the first sixteen entries are the basic colours.
+func paletteRGB(n uint8) (r, g, b uint8) {
+	switch {
+	case n < 16:
+		p := basicPalette[n]
+		return p[0], p[1], p[2]
+	case n < 232:
+		n -= 16
+		return cubeLevels[n/36], cubeLevels[(n/6)%6], cubeLevels[n%6]
+	}
+	v := 8 + 10*(n-232)
+	return v, v, v
+}
+
+// A TextStyle holds the colours and attributes used to draw text.  The zero
+// value draws text unchanged.
+type TextStyle struct {
+	Foreground Color
+	Background Color
+	Bold       bool
+	Dim        bool
+	Italic     bool
+	Underline  bool
+}
+
+// IsZero reports whether the style leaves text unchanged.
+func (s TextStyle) IsZero() bool {
+	return s == TextStyle{}
+}
+
+// merge returns the style with over layered on top: colours set in over
+// replace those of s, while attributes accumulate.
+func (s TextStyle) merge(over TextStyle) TextStyle {
+	if over.Foreground != 0 {
+		s.Foreground = over.Foreground
+	}
+	if over.Background != 0 {
+		s.Background = over.Background
+	}
+	s.Bold = s.Bold || over.Bold
+	s.Dim = s.Dim || over.Dim
+	s.Italic = s.Italic || over.Italic
+	s.Underline = s.Underline || over.Underline
+	return s
+}
+
+// downgrade returns the style as it can be shown with the given colour
+// depth; with term.NoColor, that is the zero style.
+func (s TextStyle) downgrade(depth term.ColorDepth) TextStyle {
+	if depth == term.NoColor {
+		return TextStyle{}
+	}
+	s.Foreground = s.Foreground.downgrade(depth)
+	s.Background = s.Background.downgrade(depth)
+	return s
+}
+
+// sgrReset ends any SGR styling started by a TextStyle.
+const sgrReset = "\033[0m"
+
+// sgr returns the SGR escape sequence which starts this style, or an empty
+// string for the zero style.
+func (s TextStyle) sgr() string {
+	params := make([]string, 0, 6)
+	if s.Bold {
+		params = append(params, "1")
+	}
+	if s.Dim {
+		params = append(params, "2")
+	}
+	if s.Italic {
+		params = append(params, "3")
+	}
+	if s.Underline {
+		params = append(params, "4")
+	}
+	if s.Foreground != 0 {
+		params = append(params, s.Foreground.sgr(false))
+	}
+	if s.Background != 0 {
+		params = append(params, s.Background.sgr(true))
+	}
+	if len(params) == 0 {
+		return ""
+	}
+	return "\033[" + strings.Join(params, ";") + "m"
+}
+
+// apply wraps text in the SGR sequences for this style.
+func (s TextStyle) apply(text string) string {
+	start := s.sgr()
+	if start == "" || text == "" {
+		return text
+	}
+	return start + text + sgrReset
+}
+
+// css returns the CSS declarations equivalent to this style.
+func (s TextStyle) css() []string {
+	decls := []string{}
+	if s.Foreground != 0 {
+		decls = append(decls, "color: "+s.Foreground.css())
+	}
+	if s.Background != 0 {
+		decls = append(decls, "background-color: "+s.Background.css())
+	}
+	if s.Bold {
+		decls = append(decls, "font-weight: bold")
+	}
+	if s.Dim {
+		decls = append(decls, "opacity: 0.5")
+	}
+	if s.Italic {
+		decls = append(decls, "font-style: italic")
+	}
+	if s.Underline {
+		decls = append(decls, "text-decoration: underline")
+	}
+	return decls
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apcera/termtables/term"
+)
+
+func TestTextStyleSGR(t *testing.T) {
+	tests := []struct {
+		style TextStyle
+		sgr   string
+	}{
+		{TextStyle{}, ""},
+		{TextStyle{Bold: true}, "\033[1m"},
+		{TextStyle{Dim: true, Italic: true, Underline: true}, "\033[2;3;4m"},
+		{TextStyle{Foreground: ColorRed}, "\033[31m"},
+		{TextStyle{Foreground: ColorBrightCyan}, "\033[96m"},
+		{TextStyle{Background: ColorBlue}, "\033[44m"},
+		{TextStyle{Background: ColorBrightWhite}, "\033[107m"},
+		{TextStyle{Foreground: Color256(208)}, "\033[38;5;208m"},
+		{TextStyle{Background: ColorRGB(1, 2, 3)}, "\033[48;2;1;2;3m"},
+		{TextStyle{Bold: true, Foreground: ColorGreen, Background: ColorBlack}, "\033[1;32;40m"},
+	}
+	for _, test := range tests {
+		if got := test.style.sgr(); got != test.sgr {
+			t.Errorf("Unexpected SGR for %+v; expected %q but got %q", test.style, test.sgr, got)
+		}
+	}
+}
+
+func TestTextStyleCSS(t *testing.T) {
+	style := TextStyle{Foreground: ColorRed, Background: Color256(231), Bold: true, Underline: true}
+	expected := "color: #cd0000; background-color: #ffffff; font-weight: bold; text-decoration: underline"
+	if got := strings.Join(style.css(), "; "); got != expected {
+		t.Fatalf("Unexpected CSS; expected %q but got %q", expected, got)
+	}
+}
+
+func TestTextStyleMerge(t *testing.T) {
+	base := TextStyle{Foreground: ColorRed, Background: ColorBlack, Bold: true}
+	got := base.merge(TextStyle{Foreground: ColorGreen, Underline: true})
+	expected := TextStyle{Foreground: ColorGreen, Background: ColorBlack, Bold: true, Underline: true}
+	if got != expected {
+		t.Fatalf("Unexpected merged style; expected %+v but got %+v", expected, got)
+	}
+}
+
+func TestTableCellRowColumnStyles(t *testing.T) {
+	expected := "" +
+		"+------+-------+\n" +
+		"| Name | State |\n" +
+		"+------+-------+\n" +
+		"| web  | \033[32mup\033[0m    |\n" +
+		"| \033[1mdb\033[0m   | \033[1;31mdown\033[0m  |\n" +
+		"| \033[4mmq\033[0m   | \033[33mslow\033[0m  |\n" +
+		"+------+-------+\n"
+
+	table := CreateTable()
+	table.SetColorDepth(term.TrueColor)
+	table.AddHeaders("Name", "State")
+	table.AddRow("web", "up")
+	row := table.AddRow("db", CreateCell("down", &CellStyle{TextStyle: TextStyle{Foreground: ColorRed}}))
+	row.SetStyle(TextStyle{Bold: true})
+	table.AddRow(CreateCell("mq", &CellStyle{TextStyle: TextStyle{Underline: true}}),
+		CreateCell("slow", &CellStyle{TextStyle: TextStyle{Foreground: ColorYellow}}))
+	table.SetColumnStyle(2, TextStyle{Foreground: ColorGreen})
+
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableStylesDroppedInMarkdown(t *testing.T) {
+	expected := "" +
+		"| Name | State |\n" +
+		"| ---- | ----- |\n" +
+		"| web  | up    |\n"
+
+	table := CreateTable()
+	table.SetModeMarkdown()
+	table.AddHeaders("Name", "State")
+	table.AddRow("web", "up").SetStyle(TextStyle{Bold: true})
+	table.SetColumnStyle(2, TextStyle{Foreground: ColorGreen})
+
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableBorderAndHeaderStyles(t *testing.T) {
+	dim := func(in string) string { return "\033[2;90m" + in + "\033[0m" }
+	expected := "" +
+		dim("+-------+") + "\n" +
+		dim("|") + "  Box  " + dim("|") + "\n" +
+		dim("+---+---+") + "\n" +
+		dim("|") + " \033[1;36mA\033[0m " + dim("|") + " \033[1;36mB\033[0m " + dim("|") + "\n" +
+		dim("+---+---+") + "\n" +
+		dim("|") + " 1 " + dim("|") + " 2 " + dim("|") + "\n" +
+		dim("+---+---+") + "\n"
+
+	table := CreateTable()
+	table.SetColorDepth(term.TrueColor)
+	table.Style = &TableStyle{
+		BorderX: "-", BorderY: "|", BorderI: "+",
+		PaddingLeft: 1, PaddingRight: 1,
+		Alignment:       AlignLeft,
+		BorderTextStyle: TextStyle{Foreground: ColorBrightBlack, Dim: true},
+		HeaderTextStyle: TextStyle{Foreground: ColorCyan, Bold: true},
+	}
+	table.AddTitle("Box")
+	table.AddHeaders("A", "B")
+	table.AddRow(1, 2)
+
+	checkRendersTo(t, table, expected)
+}
+
+func TestColorDowngrade(t *testing.T) {
+	tests := []struct {
+		in    Color
+		depth term.ColorDepth
+		out   Color
+	}{
+		{ColorRed, term.TrueColor, ColorRed},
+		{ColorRed, term.Colors16, ColorRed},
+		{ColorRed, term.NoColor, 0},
+		{Color256(9), term.Colors16, ColorBrightRed},
+		{Color256(196), term.Colors16, ColorBrightRed},
+		{Color256(196), term.Colors256, Color256(196)},
+		{ColorRGB(0xff, 0x00, 0x00), term.TrueColor, ColorRGB(0xff, 0x00, 0x00)},
+		{ColorRGB(0xfe, 0x01, 0x02), term.Colors256, Color256(196)},
+		{ColorRGB(0x80, 0x80, 0x80), term.Colors256, Color256(244)},
+		{ColorRGB(0x00, 0x00, 0xf0), term.Colors16, ColorBlue},
+	}
+	for _, test := range tests {
+		if got := test.in.downgrade(test.depth); got != test.out {
+			t.Errorf("Unexpected downgrade of %#x to depth %d; expected %#x but got %#x",
+				test.in, test.depth, test.out, got)
+		}
+	}
+}
+
+func TestTableColorDepth(t *testing.T) {
+	table := CreateTable()
+	table.AddRow("\033[1mbold\033[0m", CreateCell("red", &CellStyle{TextStyle: TextStyle{Foreground: ColorRGB(0xff, 0, 0)}}))
+
+	table.SetColorDepth(term.Colors16)
+	checkRendersTo(t, table, ""+
+		"+------+-----+\n"+
+		"| \033[1mbold\033[0m | \033[91mred\033[0m |\n"+
+		"+------+-----+\n")
+
+	table.SetColorDepth(term.NoColor)
+	checkRendersTo(t, table, ""+
+		"+------+-----+\n"+
+		"| bold | red |\n"+
+		"+------+-----+\n")
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// decimalWidth holds, for one column of AlignDecimal cells, the greatest
+// widths of the content on either side of the decimal separator; the right
+// side includes the separator itself.
+type decimalWidth struct {
+	left, right int
+}
+
+// splitDecimal returns the index in text at which the decimal separator
+// falls, or would fall, for the first number in text.  Digit grouping
+// characters (comma, period, space or apostrophe, other than the mark
+// itself) are allowed within the integer part of the number.  Where text
+// holds no number, the whole of it is taken to lie to the left.
+func splitDecimal(text string, mark rune) int {
+	start := -1
+	for i, r := range text {
+		if isDigit(r) {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return len(text)
+	}
+
+	i := start
+	for i < len(text) {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		switch {
+		case isDigit(r):
+			i += size
+			continue
+		case r != mark && (r == ',' || r == '.' || r == ' ' || r == '\''):
+			// a grouping character only if followed by a digit
+			if next, _ := utf8.DecodeRuneInString(text[i+size:]); isDigit(next) {
+				i += size
+				continue
+			}
+		}
+		break
+	}
+	return i
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// decimalMark returns the character used as the decimal separator for
+// AlignDecimal in a column, counting from 0: that of the NumberFormat for
+// the column, if it sets one, or else the table's.
+func (s *renderStyle) decimalMark(column int) rune {
+	if cc := s.columnConfigs[column]; cc != nil && cc.numberFormat != nil && cc.numberFormat.DecimalMark != 0 {
+		return cc.numberFormat.DecimalMark
+	}
+	if nf := s.tableNumberFormat; nf != nil && nf.DecimalMark != 0 {
+		return nf.DecimalMark
+	}
+	if s.DecimalMark == 0 {
+		return '.'
+	}
+	return s.DecimalMark
+}
+
+// decimalSplit returns the widths of content in a column either side of its
+// decimal separator, and whether the content holds a number at all.
+func (s *renderStyle) decimalSplit(content string, column int) (left, right int, ok bool) {
+	visible := filterColorCodes(content)
+	if strings.IndexFunc(visible, isDigit) < 0 {
+		return s.textWidth(visible), 0, false
+	}
+	i := splitDecimal(visible, s.decimalMark(column))
+	left = s.textWidth(visible[:i])
+	return left, s.textWidth(visible) - left, true
+}
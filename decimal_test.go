@@ -0,0 +1,176 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func TestSplitDecimal(t *testing.T) {
+	tests := []struct {
+		in    string
+		mark  rune
+		index int
+	}{
+		{"3.5", '.', 1},
+		{"12.125", '.', 2},
+		{"100", '.', 3},
+		{"-3.5", '.', 2},
+		{"12ms", '.', 2},
+		{"3.5ms", '.', 1},
+		{"1,234.50", '.', 5},
+		{"$1,234.50", '.', 6},
+		{"1.234,50", ',', 5},
+		{"3,5", ',', 1},
+		{"n/a", '.', 3},
+		{"", '.', 0},
+		{"1.", '.', 1},
+	}
+	for _, test := range tests {
+		if got := splitDecimal(test.in, test.mark); got != test.index {
+			t.Errorf("Unexpected split of %q with %q; expected %d but got %d", test.in, test.mark, test.index, got)
+		}
+	}
+}
+
+func createDecimalTable() *Table {
+	table := CreateTable()
+	table.AddHeaders("Name", "Value")
+	table.AddRow("a", 3.5)
+	table.AddRow("b", "12.125")
+	table.AddRow("c", 100)
+	table.AddRow("d", "-7.25")
+	table.AddRow("e", "42ms")
+	table.AddRow("f", "n/a")
+	table.SetAlign(AlignDecimal, 2)
+	return table
+}
+
+func TestTableAlignDecimal(t *testing.T) {
+	expected := "" +
+		"+------+---------+\n" +
+		"| Name | Value   |\n" +
+		"+------+---------+\n" +
+		"| a    |   3.50  |\n" +
+		"| b    |  12.125 |\n" +
+		"| c    | 100     |\n" +
+		"| d    |  -7.25  |\n" +
+		"| e    |  42ms   |\n" +
+		"| f    | n/a     |\n" +
+		"+------+---------+\n"
+
+	checkRendersTo(t, createDecimalTable(), expected)
+}
+
+func TestTableAlignDecimalComma(t *testing.T) {
+	expected := "" +
+		"+----------+\n" +
+		"|     3,5  |\n" +
+		"| 1.234,25 |\n" +
+		"|    10    |\n" +
+		"+----------+\n"
+
+	table := CreateTable()
+	table.Style = &TableStyle{
+		BorderX: "-", BorderY: "|", BorderI: "+",
+		PaddingLeft: 1, PaddingRight: 1,
+		Alignment:   AlignDecimal,
+		DecimalMark: ',',
+	}
+	table.AddRow("3,5")
+	table.AddRow("1.234,25")
+	table.AddRow("10")
+
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableAlignDecimalInMarkdown(t *testing.T) {
+	expected := "" +
+		"| Name | Value   |\n" +
+		"| ---- | ------- |\n" +
+		"| a    |   3.50  |\n" +
+		"| b    |  12.125 |\n" +
+		"| c    | 100     |\n" +
+		"| d    |  -7.25  |\n" +
+		"| e    |  42ms   |\n" +
+		"| f    | n/a     |\n"
+
+	table := createDecimalTable()
+	table.SetModeMarkdown()
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableAlignDecimalHTML(t *testing.T) {
+	td := "<td align='right' style='font-variant-numeric: tabular-nums'>"
+	expected := "<table class=\"termtable\">\n" +
+		"<thead>\n" +
+		"<tr><th>Name</th><th>Value</th></tr>\n" +
+		"</thead>\n" +
+		"<tbody>\n" +
+		"<tr><td>a</td>" + td + "3.50&#x2007;</td></tr>\n" +
+		"<tr><td>b</td>" + td + "12.125</td></tr>\n" +
+		"<tr><td>c</td>" + td + "100&#x2007;&#x2007;&#x2007;&#x2007;</td></tr>\n" +
+		"<tr><td>d</td>" + td + "-7.25&#x2007;</td></tr>\n" +
+		"<tr><td>e</td>" + td + "42ms&#x2007;&#x2007;</td></tr>\n" +
+		"<tr><td>f</td><td>n/a</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+
+	table := createDecimalTable()
+	table.SetModeHTML()
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableAlignDecimalLongHeader(t *testing.T) {
+	expected := "" +
+		"+------+-------------------+\n" +
+		"| Name | Value in 2026 USD |\n" +
+		"+------+-------------------+\n" +
+		"| a    |   3.50            |\n" +
+		"| b    |  12.125           |\n" +
+		"| c    | 100               |\n" +
+		"| f    | not applicable    |\n" +
+		"+------+-------------------+\n"
+
+	table := CreateTable()
+	table.Style = &TableStyle{
+		BorderX: "-", BorderY: "|", BorderI: "+",
+		PaddingLeft: 1, PaddingRight: 1,
+		Alignment: AlignDecimal,
+	}
+	table.AddHeaders("Name", "Value in 2026 USD")
+	table.AddRow("a", 3.5)
+	table.AddRow("b", "12.125")
+	table.AddRow("c", 100)
+	table.AddRow("f", "not applicable")
+	table.SetAlign(AlignLeft, 1)
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableAlignDecimalHeaderHTML(t *testing.T) {
+	td := "<td align='right' style='font-variant-numeric: tabular-nums'>"
+	expected := "<table class=\"termtable\">\n" +
+		"<thead>\n" +
+		"<tr><th>Name</th><th>Value in 2026 USD</th></tr>\n" +
+		"</thead>\n" +
+		"<tbody>\n" +
+		"<tr><td align='left'>a</td>" + td + "3.50&#x2007;</td></tr>\n" +
+		"<tr><td align='left'>b</td>" + td + "12.125</td></tr>\n" +
+		"<tr><td align='left'>f</td><td>not applicable</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+
+	table := CreateTable()
+	table.Style = &TableStyle{
+		BorderX: "-", BorderY: "|", BorderI: "+",
+		PaddingLeft: 1, PaddingRight: 1,
+		Alignment: AlignDecimal,
+	}
+	table.AddHeaders("Name", "Value in 2026 USD")
+	table.AddRow("a", 3.5)
+	table.AddRow("b", "12.125")
+	table.AddRow("f", "not applicable")
+	table.SetAlign(AlignLeft, 1)
+	table.SetModeHTML()
+	checkRendersTo(t, table, expected)
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// These are the C1 control characters which introduce or end escape
+// sequences; they are the 8-bit equivalents of "ESC x" for x in @ to _.
+const (
+	c1DCS = '\u0090' // Device Control String, ESC P
+	c1SOS = '\u0098' // Start Of String, ESC X
+	c1CSI = '\u009b' // Control Sequence Introducer, ESC [
+	c1ST  = '\u009c' // String Terminator, ESC \
+	c1OSC = '\u009d' // Operating System Command, ESC ]
+	c1PM  = '\u009e' // Privacy Message, ESC ^
+	c1APC = '\u009f' // Application Program Command, ESC _
+)
+
+// stripEscapes returns s with all terminal escape sequences removed, per
+// ECMA-48: control sequences (CSI, which includes SGR and cursor movement),
+// control strings (OSC, DCS, SOS, PM and APC, terminated by ST or, for OSC,
+// by BEL), and other escape sequences such as "ESC 7" or "ESC ( B".  Both
+// the 7-bit "ESC x" and the 8-bit C1 forms of the introducers are handled;
+// the C1 forms are recognised as the Unicode code points, U+0080 to U+009F,
+// so are not confused with UTF-8 continuation bytes.  An unterminated
+// sequence runs to the end of the string.
+func stripEscapes(s string) string {
+	if strings.IndexByte(s, '\033') < 0 && strings.IndexByte(s, 0xc2) < 0 {
+		// no ESC, nor any C1 control characters, which in UTF-8 all
+		// start with 0xC2
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		if n := sequenceLength(s[i:]); n > 0 {
+			i += n
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		b.WriteString(s[i : i+size])
+		i += size
+	}
+	return b.String()
+}
+
+// sequenceLength returns the length of the escape sequence, in either the
+// 7-bit or the C1 form, at the start of s, or 0 if s does not start with one.
+func sequenceLength(s string) int {
+	r, size := utf8.DecodeRuneInString(s)
+	switch {
+	case r == '\033':
+		return escapeLength(s)
+	case r == c1CSI:
+		return size + csiLength(s[size:])
+	case r == c1OSC:
+		return size + controlStringLength(s[size:], true)
+	case r == c1DCS || r == c1SOS || r == c1PM || r == c1APC:
+		return size + controlStringLength(s[size:], false)
+	}
+	return 0
+}
+
+// trimTrailingSpace returns s without the spaces at its end, including any
+// amongst or before escape sequences at the end, such as an SGR reset after
+// padding drawn in a style; the escape sequences themselves are kept.
+func trimTrailingSpace(s string) string {
+	spaces := -1
+	escapes := ""
+	for i := 0; i < len(s); {
+		if n := sequenceLength(s[i:]); n > 0 {
+			if spaces >= 0 {
+				escapes += s[i : i+n]
+			}
+			i += n
+			continue
+		}
+		if s[i] == ' ' {
+			if spaces < 0 {
+				spaces, escapes = i, ""
+			}
+		} else {
+			spaces = -1
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+	}
+	if spaces < 0 {
+		return s
+	}
+	return s[:spaces] + escapes
+}
+
+// escapeLength returns the length of the escape sequence at the start of s,
+// which begins with ESC.
+func escapeLength(s string) int {
+	if len(s) < 2 {
+		return len(s)
+	}
+	switch c := s[1]; {
+	case c == '[':
+		return 2 + csiLength(s[2:])
+	case c == ']':
+		return 2 + controlStringLength(s[2:], true)
+	case c == 'P' || c == 'X' || c == '^' || c == '_':
+		return 2 + controlStringLength(s[2:], false)
+	}
+
+	// Any number of intermediate bytes, then a final byte.
+	n := 1
+	for n < len(s) && s[n] >= 0x20 && s[n] <= 0x2f {
+		n++
+	}
+	if n < len(s) && s[n] >= 0x30 && s[n] <= 0x7e {
+		n++
+	}
+	return n
+}
+
+// csiLength returns the length of the control sequence at the start of s,
+// which follows a CSI: parameter bytes, then intermediate bytes, then a
+// final byte.  If a byte out of place is found, the sequence is taken to
+// stop short of it.
+func csiLength(s string) int {
+	n := 0
+	for n < len(s) && s[n] >= 0x30 && s[n] <= 0x3f {
+		n++
+	}
+	for n < len(s) && s[n] >= 0x20 && s[n] <= 0x2f {
+		n++
+	}
+	if n < len(s) && s[n] >= 0x40 && s[n] <= 0x7e {
+		n++
+	}
+	return n
+}
+
+// controlStringLength returns the length of the control string at the start
+// of s, up to and including the String Terminator.  OSC strings may also be
+// terminated by BEL, as is common practice.
+func controlStringLength(s string, bel bool) int {
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == c1ST:
+			return i + size
+		case r == '\a' && bel:
+			return i + size
+		case r == '\033' && i+1 < len(s) && s[i+1] == '\\':
+			return i + 2
+		}
+		i += size
+	}
+	return len(s)
+}
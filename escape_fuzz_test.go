@@ -0,0 +1,46 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+//go:build go1.18
+// +build go1.18
+
+package termtables
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// FuzzStripEscapes checks that stripEscapes leaves no escape sequences in
+// valid UTF-8, and that it agrees with colorFilter for all strings in which
+// colorFilter leaves no escape sequences behind.
+func FuzzStripEscapes(f *testing.F) {
+	for _, seed := range []string{
+		"abc",
+		"\033[31mfoo\033[0mbar",
+		"\033[31;4;43mfoo\033[mbar",
+		"\033]8;;http://example.com/\033\\link\033]8;;\033\\",
+		"\033]8;id=1;http://example.com/\alink\033]8;;\a",
+		"\033[2K\033]0;title\a",
+	} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		if !utf8.ValidString(s) {
+			return
+		}
+		stripped := stripEscapes(s)
+		if strings.ContainsRune(stripped, '\033') || strings.ContainsRune(stripped, c1CSI) {
+			t.Fatalf("stripEscapes(%q) left an escape behind: %q", s, stripped)
+		}
+
+		want := colorFilter.ReplaceAllString(s, "")
+		if strings.ContainsRune(want, '\033') || strings.ContainsAny(s, "\u0090\u0098\u009b\u009c\u009d\u009e\u009f") {
+			// colorFilter knows nothing of these
+			return
+		}
+		if stripped != want {
+			t.Fatalf("stripEscapes(%q) = %q but colorFilter gives %q", s, stripped, want)
+		}
+	})
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/apcera/termtables/term"
+)
+
+// Must match SGR escape sequence, which is "CSI Pm m", where the Control
+// Sequence Introducer (CSI) is "ESC ["; where Pm is "A multiple numeric
+// parameter composed of any number of single numeric parameters, separated
+// by ; character(s).  Individual values for the parameters are listed with
+// Ps" and where Ps is A single (usually optional) numeric parameter,
+// composed of one of [sic] more digits."
+//
+// In practice, the end sequence is usually given as \e[0m but reading that
+// definition, it's clear that the 0 is optional and some testing confirms
+// that it is certainly optional with MacOS Terminal 2.3, so we need to
+// support the string \e[m as a terminator too.
+//
+// We also match OSC 8 hyperlinks, "OSC 8 ; params ; URI ST", where OSC is
+// "ESC ]" and the String Terminator (ST) is "ESC \\", or BEL in practice.
+//
+// This was the filter used before stripEscapes, kept as a reference for it.
+var colorFilter = regexp.MustCompile(`\033\[(?:\d+(?:;\d+)*)?m|\033\]8;[^;\a\033]*;[^\a\033]*(?:\a|\033\\)`)
+
+func TestStripEscapes(t *testing.T) {
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"abc", "abc"},
+		{"Καλημέρα", "Καλημέρα"},
+		{"\033[2Kline", "line"},
+		{"a\033[10;20Hb", "ab"},
+		{"\033[?25lhidden\033[?25h", "hidden"},
+		{"\033]0;window title\007text", "text"},
+		{"\033]0;window title\033\\text", "text"},
+		{"\033]8;;http://example.com/\033\\link\033]8;;\033\\", "link"},
+		{"\033Pq#0;2;0;0;0\033\\sixel", "sixel"},
+		{"\0337saved\0338", "saved"},
+		{"\033(Bcharset", "charset"},
+		{"\u009b31mc1\u009b0m", "c1"},
+		{"\u009d0;title\u009ctext", "text"},
+		{"\u0090data\u009cdcs", "dcs"},
+		{"unterminated\033]0;title", "unterminated"},
+		{"trailing\033", "trailing"},
+		{"\033[31", ""},
+		{"bell\a", "bell\a"},
+	}
+	for _, test := range tests {
+		got := stripEscapes(test.in)
+		if got != test.out {
+			t.Errorf("Invalid escape filter result; expected %q but got %q from input %q",
+				test.out, got, test.in)
+		}
+	}
+}
+
+func TestTrimTrailingSpace(t *testing.T) {
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"abc", "abc"},
+		{"abc  ", "abc"},
+		{"  ", ""},
+		{"a b", "a b"},
+		{"\033[31mabc  \033[0m", "\033[31mabc\033[0m"},
+		{"\033[31mabc\033[0m  ", "\033[31mabc\033[0m"},
+		{"abc \033[1m \033[0m ", "abc\033[1m\033[0m"},
+		{"\033[31m  \033[0mabc", "\033[31m  \033[0mabc"},
+	}
+	for _, test := range tests {
+		if got := trimTrailingSpace(test.in); got != test.out {
+			t.Errorf("Unexpected trim of %q; expected %q but got %q", test.in, test.out, got)
+		}
+	}
+}
+
+func TestTableWithEscapes(t *testing.T) {
+	expected := "" +
+		"+-------+-------+\n" +
+		"| \033[2Kclear | \033]0;t\007title |\n" +
+		"| \u009b1mc1\u009b0m    | x     |\n" +
+		"+-------+-------+\n"
+
+	table := CreateTable()
+	table.SetColorDepth(term.TrueColor)
+	table.AddRow("\033[2Kclear", "\033]0;t\007title")
+	table.AddRow("\u009b1mc1\u009b0m", "x")
+
+	checkRendersTo(t, table, expected)
+}
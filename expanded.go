@@ -0,0 +1,141 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"strconv"
+	"strings"
+)
+
+type expandedMode int
+
+// These constants control whether a table is drawn in expanded mode in
+// terminal output, where each row is shown as a record of header and value
+// pairs, one per line, like the \x mode of psql.  ExpandedAuto uses expanded
+// mode only when the table would otherwise be wider than MaxColumns.
+const (
+	ExpandedOff expandedMode = iota
+	ExpandedOn
+	ExpandedAuto
+)
+
+// SetExpanded sets whether the table is drawn in expanded mode, in terminal
+// output; Markdown and HTML are unaffected.
+func (t *Table) SetExpanded(mode expandedMode) {
+	t.expanded = mode
+}
+
+// renderExpanded returns the table drawn in expanded mode: each data row as
+// a block of lines, one per column, headed by a rule such as
+// "-[ RECORD 3 ]-----".  The title, if any, comes first and the footer, if
+// any, is drawn as a last record; rows left out by a row limit are noted in
+// a line of their own.  Group headers and subtotals are not drawn.
+func (t *Table) renderExpanded() string {
+	tt := t.clone()
+	tt.elements = tt.limitedElements()
+	footer := t.footerRow()
+	if footer != nil {
+		tt.elements = append(tt.elements, footer)
+	}
+	style := createRenderStyle(tt)
+
+	headerRow := tt.columnHeaderRow()
+	headerRow.SetStyle(tt.Style.HeaderTextStyle)
+	columns := tt.columnCount()
+	names := make([]string, columns)
+	nameWidth := 0
+	for i := range names {
+		if i < len(headerRow.cells) {
+			names[i] = headerRow.cells[i].content(style, headerRow)
+		} else {
+			names[i] = strconv.Itoa(i + 1)
+		}
+		if w := style.textWidth(names[i]); w > nameWidth {
+			nameWidth = w
+		}
+	}
+
+	type record struct {
+		label  string
+		values []string
+		note   string
+	}
+	records := []record{}
+	valueWidth := 0
+	number := 0
+	for _, e := range tt.elements {
+		row, ok := e.(*Row)
+		switch {
+		case !ok:
+			continue
+		case row.elided > 0:
+			number += row.elided
+			records = append(records, record{note: row.cells[0].content(style, row)})
+			continue
+		case row.header, row.footer && row != footer:
+			// group headers and subtotals from GroupBy
+			continue
+		}
+
+		r := record{values: make([]string, columns)}
+		if row == footer {
+			r.label = "FOOTER"
+		} else {
+			number++
+			r.label = "RECORD " + strconv.Itoa(number)
+		}
+		for _, c := range row.cells {
+			if c.column < columns {
+				r.values[c.column] = c.content(style, row)
+				if w := style.textWidth(r.values[c.column]); w > valueWidth {
+					valueWidth = w
+				}
+			}
+		}
+		records = append(records, r)
+	}
+
+	borderY := style.border(style.cellGap())
+	width := nameWidth + style.PaddingRight + style.textWidth(style.cellGap()) + style.PaddingLeft + valueWidth
+
+	var b strings.Builder
+	if tt.title != nil {
+		b.WriteString(strings.TrimSpace(CreateCell(tt.title, nil).render(style, nil)))
+		b.WriteString("\n")
+	}
+	for _, r := range records {
+		if r.note != "" {
+			b.WriteString(r.note)
+			b.WriteString("\n")
+			continue
+		}
+		heading := "[ " + r.label + " ]"
+		lead := style.rule(1)
+		rest := width - style.textWidth(lead+heading)
+		if rest < 1 {
+			rest = 1
+		}
+		b.WriteString(style.border(lead + heading + style.rule(rest)))
+		b.WriteString("\n")
+		for i, name := range names {
+			line := name + strings.Repeat(" ", nameWidth-style.textWidth(name)+style.PaddingRight) +
+				borderY + strings.Repeat(" ", style.PaddingLeft) + r.values[i]
+			b.WriteString(trimTrailingSpace(line))
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// maxLineWidth returns the width of the widest line of text drawn for the
+// table.
+func (t *Table) maxLineWidth(text string) int {
+	cond := t.Style.AmbiguousWidth.condition()
+	width := 0
+	for _, line := range strings.Split(text, "\n") {
+		if w := textWidth(cond, line); w > width {
+			width = w
+		}
+	}
+	return width
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func TestTableExpanded(t *testing.T) {
+	expected := "" +
+		"Hosts\n" +
+		"-[ RECORD 1 ]---\n" +
+		"Host     | web1\n" +
+		"Requests | 1200\n" +
+		"Latency  | 30ms\n" +
+		"… 1 more row …\n" +
+		"-[ RECORD 3 ]---\n" +
+		"Host     | web3\n" +
+		"Requests | 2500\n" +
+		"Latency  | 10ms\n" +
+		"-[ FOOTER ]-----\n" +
+		"Host     | Total\n" +
+		"Requests | 4500\n" +
+		"Latency  |\n"
+
+	table := createFooterTable()
+	table.AddTitle("Hosts")
+	table.AddFooter("Total", AggregateSum)
+	table.SetRowLimit(1, 1)
+	table.SetExpanded(ExpandedOn)
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableExpandedWithoutHeaders(t *testing.T) {
+	expected := "" +
+		"-[ RECORD 1 ]-\n" +
+		"1 | a\n" +
+		"2 | 1\n" +
+		"-[ RECORD 2 ]-\n" +
+		"1 | bb\n" +
+		"2 | 22\n"
+
+	table := CreateTable()
+	table.AddRow("a", 1)
+	table.AddSeparator()
+	table.AddRow("bb", 22)
+	table.SetExpanded(ExpandedOn)
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableExpandedAuto(t *testing.T) {
+	defer func(columns int) { MaxColumns = columns }(MaxColumns)
+
+	table := createFooterTable()
+	table.SetExpanded(ExpandedAuto)
+	MaxColumns = 80
+	checkRendersTo(t, table, table.renderTerminal())
+	MaxColumns = 20
+	checkRendersTo(t, table, table.renderExpanded())
+
+	table.SetModeMarkdown()
+	checkRendersTo(t, table, table.renderMarkdown())
+}
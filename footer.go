@@ -0,0 +1,207 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"math"
+	"reflect"
+)
+
+type aggregate int
+
+// These constants are the aggregates which can be computed over the values
+// in a column of a table, either with Table.Aggregate or by giving them to
+// AddFooter.
+const (
+	AggregateSum aggregate = iota + 1
+	AggregateAverage
+	AggregateMin
+	AggregateMax
+	AggregateCount
+)
+
+// AddFooter supplies items for the footer row of the table, drawn below the
+// data rows after a separator (or in a <tfoot> in HTML, or as the last row
+// in Markdown).  An item can be one of the Aggregate constants, in which
+// case the aggregate is computed over the raw values of the data rows in
+// that column whenever the table is rendered, and drawn as the column's
+// values are, with its formatting; other items are drawn as given.
+func (t *Table) AddFooter(items ...interface{}) {
+	t.footers = append(t.footers, items...)
+}
+
+// Aggregate computes an aggregate over the raw values of the data rows in a
+// column, counting from 1.  Sums and averages are of the numeric values;
+// a sum keeps the type of the values if they all have the same one (so
+// time.Durations add up to a time.Duration) and is otherwise an int64, if
+// they are all signed integers, or a float64.  An average likewise keeps the
+// type of the values if they all have the same defined type, such as
+// time.Duration, rounding it to the nearest integer for integer types, and
+// is otherwise a float64.  The minimum and maximum are found amongst all
+// non-nil values, compared as SortBy does, and the count is of the non-nil
+// values.  Where there are no suitable values, the result is nil, except for
+// the count and the sum, which are zero.
+func (t *Table) Aggregate(column int, agg aggregate) interface{} {
+	rows := []*Row{}
+	for _, e := range t.elements {
+		if row, ok := e.(*Row); ok && row.isData() {
+			rows = append(rows, row)
+		}
+	}
+	return aggregateRows(rows, column, agg)
+}
+
+// aggregateRows computes an aggregate over the raw values of some rows in a
+// column, counting from 1, as Table.Aggregate does.
+func aggregateRows(rows []*Row, column int, agg aggregate) interface{} {
+	values := []interface{}{}
+	for _, row := range rows {
+		if v := row.value(column - 1); v != nil && sortValue(v).IsValid() {
+			values = append(values, v)
+		}
+	}
+
+	switch agg {
+	case AggregateSum:
+		return sumValues(values)
+	case AggregateAverage:
+		return averageValues(values)
+	case AggregateMin, AggregateMax:
+		var best interface{}
+		for _, v := range values {
+			c := SortKey{}.compare(v, best)
+			if best == nil || (agg == AggregateMin && c < 0) || (agg == AggregateMax && c > 0) {
+				best = v
+			}
+		}
+		return best
+	case AggregateCount:
+		return len(values)
+	}
+	return nil
+}
+
+// sumValues returns the sum of the numeric values amongst values.
+func sumValues(values []interface{}) interface{} {
+	var (
+		typ      reflect.Type
+		sameType = true
+		allInt   = true
+		isum     int64
+		usum     uint64
+		fsum     float64
+	)
+	for _, v := range values {
+		rv := sortValue(v)
+		kind := numberKind(rv.Kind())
+		if kind == reflect.Invalid {
+			continue
+		}
+		switch {
+		case typ == nil:
+			typ = rv.Type()
+		case typ != rv.Type():
+			sameType = false
+		}
+		switch kind {
+		case reflect.Int:
+			isum += rv.Int()
+		case reflect.Uint:
+			usum += rv.Uint()
+			allInt = false
+		default:
+			allInt = false
+		}
+		fsum += numberFloat(rv)
+	}
+
+	switch {
+	case typ == nil:
+		return 0
+	case sameType:
+		sum := reflect.New(typ).Elem()
+		switch numberKind(typ.Kind()) {
+		case reflect.Int:
+			sum.SetInt(isum)
+		case reflect.Uint:
+			sum.SetUint(usum)
+		default:
+			sum.SetFloat(fsum)
+		}
+		return sum.Interface()
+	case allInt:
+		return isum
+	}
+	return fsum
+}
+
+// averageValues returns the average of the numeric values amongst values,
+// or nil if there are none.
+func averageValues(values []interface{}) interface{} {
+	var (
+		typ      reflect.Type
+		sameType = true
+		total    float64
+		n        int
+	)
+	for _, v := range values {
+		rv := sortValue(v)
+		if numberKind(rv.Kind()) == reflect.Invalid {
+			continue
+		}
+		switch {
+		case typ == nil:
+			typ = rv.Type()
+		case typ != rv.Type():
+			sameType = false
+		}
+		total += numberFloat(rv)
+		n++
+	}
+	if n == 0 {
+		return nil
+	}
+
+	average := total / float64(n)
+	if !sameType || typ.PkgPath() == "" {
+		return average
+	}
+	result := reflect.New(typ).Elem()
+	switch numberKind(typ.Kind()) {
+	case reflect.Int:
+		result.SetInt(int64(math.Round(average)))
+	case reflect.Uint:
+		result.SetUint(uint64(math.Round(average)))
+	default:
+		result.SetFloat(average)
+	}
+	return result.Interface()
+}
+
+// footerRow returns the row to draw as the footer of the table, with any
+// aggregates computed, or nil if there is no footer.
+func (t *Table) footerRow() *Row {
+	if t.footers == nil {
+		return nil
+	}
+	row := &Row{cells: []*Cell{}, footer: true}
+	for i, item := range t.footers {
+		agg, ok := item.(aggregate)
+		if !ok {
+			row.AddCell(item)
+			continue
+		}
+		row.AddCell(computedCell(t.Aggregate(i+1, agg)))
+	}
+	return row
+}
+
+// computedCell returns a cell holding an aggregate of its column.
+func computedCell(v interface{}) *Cell {
+	if v == nil {
+		v = ""
+	}
+	cell := CreateCell(v, nil)
+	cell.computed = true
+	return cell
+}
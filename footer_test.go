@@ -0,0 +1,142 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+	"time"
+)
+
+func createFooterTable() *Table {
+	table := CreateTable()
+	table.AddHeaders("Host", "Requests", "Latency")
+	table.AddRow("web1", 1200, 30*time.Millisecond)
+	table.AddRow("web2", 800, 50*time.Millisecond)
+	table.AddRow("web3", 2500, 10*time.Millisecond)
+	return table
+}
+
+func TestTableAggregate(t *testing.T) {
+	table := createFooterTable()
+	table.AddRow("db1", 2.5, nil)
+	table.AddRow("db2", "n/a", 40*time.Millisecond)
+
+	tests := []struct {
+		column int
+		agg    aggregate
+		want   interface{}
+	}{
+		{2, AggregateSum, 4502.5},
+		{2, AggregateAverage, 1125.625},
+		{2, AggregateMin, 2.5},
+		{2, AggregateMax, "n/a"},
+		{2, AggregateCount, 5},
+		{3, AggregateSum, 130 * time.Millisecond},
+		{3, AggregateAverage, 32500 * time.Microsecond},
+		{3, AggregateMin, 10 * time.Millisecond},
+		{3, AggregateCount, 4},
+		{1, AggregateSum, 0},
+		{1, AggregateAverage, nil},
+		{1, AggregateMin, "db1"},
+		{4, AggregateMax, nil},
+	}
+	for _, test := range tests {
+		if got := table.Aggregate(test.column, test.agg); got != test.want {
+			t.Errorf("Unexpected aggregate %d of column %d; expected %#v but got %#v", test.agg, test.column, test.want, got)
+		}
+	}
+
+	mixed := CreateTable()
+	mixed.AddRow(int8(100))
+	mixed.AddRow(int64(100))
+	if got := mixed.Aggregate(1, AggregateSum); got != int64(200) {
+		t.Errorf("Unexpected sum of mixed integers; got %#v", got)
+	}
+	if got := mixed.Aggregate(1, AggregateAverage); got != 100.0 {
+		t.Errorf("Unexpected average of mixed integers; got %#v", got)
+	}
+	mixed.AddRow(uint(5))
+	if got := mixed.Aggregate(1, AggregateSum); got != 205.0 {
+		t.Errorf("Unexpected sum of mixed numbers; got %#v", got)
+	}
+}
+
+func TestTableAggregateAfterColSpan(t *testing.T) {
+	table := createFooterTable()
+	table.AddRow(CreateCell("all", &CellStyle{ColSpan: 2}), 5*time.Millisecond)
+
+	if got := table.Aggregate(2, AggregateSum); got != 4500 {
+		t.Errorf("Unexpected sum of column 2; got %#v", got)
+	}
+	if got := table.Aggregate(3, AggregateSum); got != 95*time.Millisecond {
+		t.Errorf("Unexpected sum of column 3; got %#v", got)
+	}
+}
+
+func TestTableFooter(t *testing.T) {
+	expected := "" +
+		"+-------+----------+---------+\n" +
+		"| Host  | Requests | Latency |\n" +
+		"+-------+----------+---------+\n" +
+		"| web1  | 1,200    | 30ms    |\n" +
+		"| web2  | 800      | 50ms    |\n" +
+		"| web3  | 2,500    | 10ms    |\n" +
+		"+-------+----------+---------+\n" +
+		"| Total | 4,500    | 30ms    |\n" +
+		"+-------+----------+---------+\n"
+
+	table := createFooterTable()
+	table.AddFooter("Total", AggregateSum, AggregateAverage)
+	table.SetColumnNumberFormat(2, NumberFormat{ThousandsSeparator: ","})
+	table.SetColumnFormat(3, FormatDuration(time.Millisecond))
+	checkRendersTo(t, table, expected)
+
+	expected = "" +
+		"+-------+----------+---------+\n" +
+		"| Host  | Requests | Latency |\n" +
+		"+-------+----------+---------+\n" +
+		"| web1  | 1,200    | 30ms    |\n" +
+		"| web3  | 2,500    | 10ms    |\n" +
+		"+-------+----------+---------+\n" +
+		"| Total | 3,700    | 20ms    |\n" +
+		"+-------+----------+---------+\n"
+
+	filtered := table.Filter(func(r *Row) bool { return r.Value(2) != 800 })
+	checkRendersTo(t, filtered, expected)
+}
+
+func TestTableFooterInMarkdown(t *testing.T) {
+	expected := "" +
+		"| Host  | Requests | Latency |\n" +
+		"| ----- | -------- | ------- |\n" +
+		"| web1  | 1200     | 30ms    |\n" +
+		"| web2  | 800      | 50ms    |\n" +
+		"| web3  | 2500     | 10ms    |\n" +
+		"| Count | 3        | 10ms    |\n"
+
+	table := createFooterTable()
+	table.SetModeMarkdown()
+	table.AddFooter("Count", AggregateCount, AggregateMin)
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableFooterHTML(t *testing.T) {
+	expected := "<table class=\"termtable\">\n" +
+		"<thead>\n" +
+		"<tr><th>Host</th><th>Requests</th><th>Latency</th></tr>\n" +
+		"</thead>\n" +
+		"<tbody>\n" +
+		"<tr><td>web1</td><td>1200</td><td>30ms</td></tr>\n" +
+		"<tr><td>web2</td><td>800</td><td>50ms</td></tr>\n" +
+		"<tr><td>web3</td><td>2500</td><td>10ms</td></tr>\n" +
+		"</tbody>\n" +
+		"<tfoot>\n" +
+		"<tr><td>Max</td><td>2500</td><td>50ms</td></tr>\n" +
+		"</tfoot>\n" +
+		"</table>\n"
+
+	table := createFooterTable()
+	table.SetModeHTML()
+	table.AddFooter("Max", AggregateMax, AggregateMax)
+	checkRendersTo(t, table, expected)
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// A FormatFunc turns the value of a cell into the text drawn for it.  It can
+// be given for one cell in its CellStyle, or for a column of a table with
+// SetColumnFormat; the cell keeps the raw value, which is what sorting and
+// other processing of the table look at.  The functions in this package
+// draw any value of a type they don't handle as it would be by default.
+type FormatFunc func(v interface{}) string
+
+// A ColumnFormatFunc turns the value of a cell in a column into the text
+// drawn for it, as a FormatFunc does, but is also given the index of the
+// data row (counting from 0, in the order drawn) and the column (counting
+// from 1).  It returns a TextStyle too, which is layered over the column and
+// under the row and cell styles, so that, eg, a status can be coloured by
+// its value.  It may be called more than once for a cell in each render.
+type ColumnFormatFunc func(v interface{}, row, column int) (string, TextStyle)
+
+var (
+	iecByteUnits = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+	siByteUnits  = []string{"B", "kB", "MB", "GB", "TB", "PB", "EB"}
+	countUnits   = []string{"", "k", "M", "B", "T"}
+)
+
+// FormatBytesIEC draws a number of bytes with binary (IEC) units, such as
+// "1.5 KiB" or "320 MiB".
+func FormatBytesIEC(v interface{}) string {
+	return formatScaled(v, 1024, " ", iecByteUnits)
+}
+
+// FormatBytesSI draws a number of bytes with decimal (SI) units, such as
+// "1.5 kB" or "320 MB".
+func FormatBytesSI(v interface{}) string {
+	return formatScaled(v, 1000, " ", siByteUnits)
+}
+
+// FormatCount draws a number as a short count, such as "950", "1.2k" or
+// "34M".
+func FormatCount(v interface{}) string {
+	return formatScaled(v, 1000, "", countUnits)
+}
+
+// formatScaled draws a number divided down by powers of base until it is
+// below base, with the unit for that power appended after sep.  As with
+// "ls -h", one decimal place is shown for scaled values below ten.
+func formatScaled(v interface{}, base float64, sep string, units []string) string {
+	f, _, _, ok := numberValue(v)
+	if !ok || math.IsNaN(f) || math.IsInf(f, 0) {
+		return renderValue(v)
+	}
+	sign := ""
+	if f < 0 {
+		sign, f = "-", -f
+	}
+	if math.Round(f) < base {
+		return sign + strconv.FormatFloat(f, 'f', 0, 64) + sep + units[0]
+	}
+
+	i := 0
+	var text string
+	for {
+		f /= base
+		i++
+		if f < 9.95 {
+			text = strconv.FormatFloat(f, 'f', 1, 64)
+		} else {
+			text = strconv.FormatFloat(f, 'f', 0, 64)
+		}
+		if i == len(units)-1 || math.Round(f) < base {
+			break
+		}
+	}
+	return sign + text + sep + units[i]
+}
+
+// FormatDuration returns a FormatFunc which draws a time.Duration rounded to
+// a multiple of unit, such as "1.5s" for unit time.Millisecond*100.
+func FormatDuration(unit time.Duration) FormatFunc {
+	return func(v interface{}) string {
+		d, ok := v.(time.Duration)
+		if !ok {
+			return renderValue(v)
+		}
+		return d.Round(unit).String()
+	}
+}
+
+// FormatTime returns a FormatFunc which draws a time.Time with the given
+// layout, as understood by time.Time.Format.
+func FormatTime(layout string) FormatFunc {
+	return func(v interface{}) string {
+		t, ok := v.(time.Time)
+		if !ok {
+			return renderValue(v)
+		}
+		return t.Format(layout)
+	}
+}
+
+// FormatRelativeTime returns a FormatFunc which draws a time.Time relative to
+// the time returned by now, such as "3m ago" or "in 2h", using the largest
+// whole unit of seconds, minutes, hours, days or years.  If now is nil,
+// time.Now is used; tests can supply a fixed clock instead.
+func FormatRelativeTime(now func() time.Time) FormatFunc {
+	if now == nil {
+		now = time.Now
+	}
+	return func(v interface{}) string {
+		t, ok := v.(time.Time)
+		if !ok {
+			return renderValue(v)
+		}
+		d := now().Sub(t)
+		future := d < 0
+		if future {
+			d = -d
+		}
+
+		var text string
+		switch {
+		case d < time.Second:
+			return "now"
+		case d < time.Minute:
+			text = strconv.Itoa(int(d/time.Second)) + "s"
+		case d < time.Hour:
+			text = strconv.Itoa(int(d/time.Minute)) + "m"
+		case d < 24*time.Hour:
+			text = strconv.Itoa(int(d/time.Hour)) + "h"
+		case d < 365*24*time.Hour:
+			text = strconv.Itoa(int(d/(24*time.Hour))) + "d"
+		default:
+			text = strconv.Itoa(int(d/(365*24*time.Hour))) + "y"
+		}
+		if future {
+			return "in " + text
+		}
+		return text + " ago"
+	}
+}
+
+// cellText returns the text for the value of a cell in a row, before any
+// sanitising or styling: formatted by the column's FormatFunc, a registered
+// Formatter or the NumberFormat, unless the cell has a FormatFunc of its
+// own, in which case the cell was formatted when it was created.  Cells
+// suppressed by GroupBy are blank.
+func (s *renderStyle) cellText(r *Row, c *Cell) string {
+	if s.suppressed[c] {
+		return ""
+	}
+	if c.format != nil {
+		return c.formattedValue
+	}
+	if cc := s.columnConfig(r, c); cc != nil && cc.format != nil {
+		text, _ := cc.format(c.value, s.rowIndexes[r], r.columnOf(c)+1)
+		return text
+	}
+	return valueFormatter{
+		registries: []*formatterRegistry{s.formatters, &defaultFormatters},
+		number:     s.numberFormat(r, c),
+	}.format(c.value)
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/apcera/termtables/term"
+)
+
+func TestFormatFuncs(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	relative := FormatRelativeTime(func() time.Time { return now })
+
+	tests := []struct {
+		name   string
+		format FormatFunc
+		value  interface{}
+		want   string
+	}{
+		{"iec", FormatBytesIEC, 0, "0 B"},
+		{"iec", FormatBytesIEC, 1023, "1023 B"},
+		{"iec", FormatBytesIEC, 1024, "1.0 KiB"},
+		{"iec", FormatBytesIEC, 1536, "1.5 KiB"},
+		{"iec", FormatBytesIEC, int64(320 << 20), "320 MiB"},
+		{"iec", FormatBytesIEC, uint64(1<<20 - 1), "1.0 MiB"},
+		{"iec", FormatBytesIEC, -2048, "-2.0 KiB"},
+		{"iec", FormatBytesIEC, "n/a", "n/a"},
+		{"si", FormatBytesSI, 999, "999 B"},
+		{"si", FormatBytesSI, 1500, "1.5 kB"},
+		{"si", FormatBytesSI, 12345678, "12 MB"},
+		{"si", FormatBytesSI, 9.96e6, "10 MB"},
+		{"count", FormatCount, 950, "950"},
+		{"count", FormatCount, 1234, "1.2k"},
+		{"count", FormatCount, 999999, "1.0M"},
+		{"count", FormatCount, 34e6, "34M"},
+		{"count", FormatCount, 2.5e15, "2500T"},
+		{"duration", FormatDuration(time.Millisecond), 1234567 * time.Microsecond, "1.235s"},
+		{"duration", FormatDuration(time.Second), 90*time.Minute + 400*time.Millisecond, "1h30m0s"},
+		{"duration", FormatDuration(time.Second), 42, "42"},
+		{"time", FormatTime("2006-01-02 15:04"), now, "2026-03-01 12:00"},
+		{"time", FormatTime(time.RFC3339), "soon", "soon"},
+		{"relative", relative, now, "now"},
+		{"relative", relative, now.Add(-3*time.Minute - 20*time.Second), "3m ago"},
+		{"relative", relative, now.Add(-42 * time.Second), "42s ago"},
+		{"relative", relative, now.Add(2 * time.Hour), "in 2h"},
+		{"relative", relative, now.Add(-50 * time.Hour), "2d ago"},
+		{"relative", relative, now.AddDate(-3, 0, -1), "3y ago"},
+	}
+	for _, test := range tests {
+		if got := test.format(test.value); got != test.want {
+			t.Errorf("Unexpected %s formatting of %#v; expected %q but got %q", test.name, test.value, test.want, got)
+		}
+	}
+}
+
+func TestTableColumnFormat(t *testing.T) {
+	expected := "" +
+		"+----------+---------+---------+\n" +
+		"| File     | Size    | Age     |\n" +
+		"+----------+---------+---------+\n" +
+		"| a.tar.gz | 1.5 GiB | 3m ago  |\n" +
+		"| notes    | 812 B   | 2d ago  |\n" +
+		"| pending  | -       | unknown |\n" +
+		"+----------+---------+---------+\n"
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	table := CreateTable()
+	table.AddHeaders("File", "Size", "Age")
+	table.AddRow("a.tar.gz", int64(1610612736), now.Add(-3*time.Minute))
+	table.AddRow("notes", 812, now.Add(-49*time.Hour))
+	table.AddRow("pending", CreateCell(0, &CellStyle{Format: func(interface{}) string { return "-" }}), "unknown")
+	table.SetColumnFormat(2, FormatBytesIEC)
+	table.SetColumnFormat(3, FormatRelativeTime(func() time.Time { return now }))
+	checkRendersTo(t, table, expected)
+
+	row := table.elements[0].(*Row)
+	if v := row.cells[1].value; v != int64(1610612736) {
+		t.Errorf("Raw value of formatted cell was not kept; got %#v", v)
+	}
+}
+
+func TestTableColumnFormatInMarkdown(t *testing.T) {
+	expected := "" +
+		"| Host | Up    |\n" +
+		"| ---- | ----- |\n" +
+		"| web1 | <yes> |\n" +
+		"| web2 | <no>  |\n"
+
+	table := CreateTable()
+	table.SetModeMarkdown()
+	table.AddHeaders("Host", "Up")
+	table.AddRow("web1", true)
+	table.AddRow("web2", false)
+	table.SetColumnFormat(2, func(v interface{}) string {
+		if v.(bool) {
+			return "<yes>"
+		}
+		return "<no>"
+	})
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableColumnFormatFunc(t *testing.T) {
+	green := "\033[32m"
+	red := "\033[31m"
+	reset := "\033[0m"
+	expected := "" +
+		"+---+----------+--------+\n" +
+		"| # | ID       | Status |\n" +
+		"+---+----------+--------+\n" +
+		"| 1 | 3f2a9c1e | " + green + "ok" + reset + "     |\n" +
+		"| 2 | 77b0d4e2 | " + red + "failed" + reset + " |\n" +
+		"+---+----------+--------+\n"
+
+	table := CreateTable()
+	table.SetColorDepth(term.Colors16)
+	table.AddHeaders("#", "ID", "Status")
+	table.AddRow("", "3f2a9c1e-5b7d-4e0a-9c3b-2d1f8e6a4b10", true)
+	table.AddRow("", "77b0d4e2-1a3c-4f5e-8b9d-0c2e4a6f8d13", false)
+	table.SetColumnFormatFunc(1, func(v interface{}, row, column int) (string, TextStyle) {
+		return renderValue(row + column), TextStyle{}
+	})
+	table.SetColumnFormat(2, func(v interface{}) string {
+		return v.(string)[:8]
+	})
+	table.SetColumnFormatFunc(3, func(v interface{}, row, column int) (string, TextStyle) {
+		if v.(bool) {
+			return "ok", TextStyle{Foreground: ColorGreen}
+		}
+		return "failed", TextStyle{Foreground: ColorRed}
+	})
+	checkRendersTo(t, table, expected)
+
+	row := table.elements[1].(*Row)
+	if v := row.cells[1].Value(); v != "77b0d4e2-1a3c-4f5e-8b9d-0c2e4a6f8d13" {
+		t.Errorf("Raw value of formatted cell was not kept; got %#v", v)
+	}
+}
+
+func TestTableColumnSettingsAfterColSpan(t *testing.T) {
+	expected := "" +
+		"+------+--------+--------+\n" +
+		"| Host | Load   | Uptime |\n" +
+		"+------+--------+--------+\n" +
+		"| web  | 1.500  | 2s@3   |\n" +
+		"| all           | 5.5s@3 |\n" +
+		"+------+--------+--------+\n"
+
+	table := CreateTable()
+	table.AddHeaders("Host", "Load", "Uptime")
+	table.AddRow("web", 1.5, 2*time.Second)
+	table.AddRow(CreateCell("all", &CellStyle{ColSpan: 2}), 5500*time.Millisecond)
+	table.SetColumnNumberFormat(2, NumberFormat{Precision: 3})
+	table.SetColumnFormatFunc(3, func(v interface{}, row, column int) (string, TextStyle) {
+		return fmt.Sprintf("%v@%d", v, column), TextStyle{}
+	})
+	checkRendersTo(t, table, expected)
+}
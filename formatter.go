@@ -0,0 +1,165 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// A Formatter turns values of some type into the text drawn for them in
+// cells.  Formatters are registered against a type, either for every table
+// with RegisterFormatter or for one table with Table.RegisterFormatter.
+type Formatter interface {
+	Format(v interface{}) string
+}
+
+// Format calls f(v), so that a FormatFunc can be registered as a Formatter.
+func (f FormatFunc) Format(v interface{}) string {
+	return f(v)
+}
+
+// FormatJoin returns a FormatFunc which draws each element of a slice or
+// array as it would be drawn on its own, joined by sep.  Slices are joined
+// with ", " by default; this allows another separator for some type.
+func FormatJoin(sep string) FormatFunc {
+	return func(v interface{}) string {
+		return valueFormatter{registries: []*formatterRegistry{&defaultFormatters}, join: sep}.format(v)
+	}
+}
+
+// formatterRegistry maps types to the Formatters registered for them.
+// Interface types are kept apart, in the order registered, as they are
+// matched by checking whether a value's type implements them.
+type formatterRegistry struct {
+	exact      map[reflect.Type]Formatter
+	interfaces []interfaceFormatter
+	nilValue   Formatter
+}
+
+type interfaceFormatter struct {
+	typ       reflect.Type
+	formatter Formatter
+}
+
+// defaultFormatters holds the Formatters registered for every table.
+var defaultFormatters formatterRegistry
+
+// RegisterFormatter sets the Formatter used for values of type typ in every
+// table, unless the table has its own registered for the type.  If typ is an
+// interface type, such as that of error, the Formatter is used for values of
+// any type implementing it which has no Formatter of its own.  A nil typ
+// registers the Formatter for nil values, including nil pointers; a nil f
+// removes any registration for typ.
+func RegisterFormatter(typ reflect.Type, f Formatter) {
+	defaultFormatters.register(typ, f)
+}
+
+func (r *formatterRegistry) register(typ reflect.Type, f Formatter) {
+	switch {
+	case typ == nil:
+		r.nilValue = f
+	case typ.Kind() == reflect.Interface:
+		for i := range r.interfaces {
+			if r.interfaces[i].typ == typ {
+				r.interfaces = append(r.interfaces[:i], r.interfaces[i+1:]...)
+				break
+			}
+		}
+		if f != nil {
+			r.interfaces = append(r.interfaces, interfaceFormatter{typ, f})
+		}
+	case f == nil:
+		delete(r.exact, typ)
+	default:
+		if r.exact == nil {
+			r.exact = map[reflect.Type]Formatter{}
+		}
+		r.exact[typ] = f
+	}
+}
+
+// lookup returns the Formatter registered for the type of v, or nil.
+func (r *formatterRegistry) lookup(v interface{}) Formatter {
+	if v == nil {
+		return r.nilValue
+	}
+	typ := reflect.TypeOf(v)
+	if f, ok := r.exact[typ]; ok {
+		return f
+	}
+	for _, i := range r.interfaces {
+		if typ.Implements(i.typ) {
+			return i.formatter
+		}
+	}
+	return nil
+}
+
+// valueFormatter draws values using the Formatters in a list of registries,
+// in order of precedence, falling back to the NumberFormat, if any, for
+// numbers and then to the default drawing of each kind of value.
+type valueFormatter struct {
+	registries []*formatterRegistry
+	number     *NumberFormat
+	join       string
+}
+
+func (vf valueFormatter) format(v interface{}) string {
+	for _, r := range vf.registries {
+		if r == nil {
+			continue
+		}
+		if f := r.lookup(v); f != nil {
+			return f.Format(v)
+		}
+	}
+	if vf.number != nil {
+		if text, ok := vf.number.format(v); ok {
+			return text
+		}
+	}
+
+	rv := reflect.ValueOf(v)
+	switch {
+	case v == nil:
+		return "<nil>"
+	case rv.Kind() == reflect.Ptr && rv.IsNil():
+		return vf.format(nil)
+	}
+
+	switch vv := v.(type) {
+	case string:
+		return vv
+	case bool:
+		return strconv.FormatBool(vv)
+	case fmt.Stringer:
+		return vv.String()
+	case error:
+		return vv.Error()
+	}
+	if text, ok := DefaultNumberFormat.format(v); ok {
+		return text
+	}
+
+	switch rv.Kind() {
+	case reflect.Ptr:
+		return vf.format(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		sep := vf.join
+		if sep == "" {
+			sep = ", "
+		}
+		items := make([]string, rv.Len())
+		for i := range items {
+			items[i] = vf.format(rv.Index(i).Interface())
+		}
+		return strings.Join(items, sep)
+	}
+	return fmt.Sprintf("%v", v)
+}
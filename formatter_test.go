@@ -0,0 +1,125 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testState int
+
+type testVersion struct {
+	major, minor int
+}
+
+var (
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+	stateType   = reflect.TypeOf(testState(0))
+	versionType = reflect.TypeOf(testVersion{})
+)
+
+func TestRenderValueDefaults(t *testing.T) {
+	n := 5
+	var nilInt *int
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{nil, "<nil>"},
+		{&n, "5"},
+		{nilInt, "<nil>"},
+		{[]string{"a", "b"}, "a, b"},
+		{[]interface{}{1, "x", 2.5}, "1, x, 2.50"},
+		{[2]bool{true, false}, "true, false"},
+		{[]string{}, ""},
+		{[]byte("hi"), "[104 105]"},
+		{errors.New("boom"), "boom"},
+		{testVersion{1, 2}, "{1 2}"},
+	}
+	for _, test := range tests {
+		if got := renderValue(test.value); got != test.want {
+			t.Errorf("Unexpected rendering of %#v; expected %q but got %q", test.value, test.want, got)
+		}
+	}
+}
+
+func TestRegisterFormatter(t *testing.T) {
+	RegisterFormatter(nil, FormatFunc(func(interface{}) string { return "-" }))
+	RegisterFormatter(versionType, FormatFunc(func(v interface{}) string {
+		vv := v.(testVersion)
+		return "v" + renderValue(vv.major) + "." + renderValue(vv.minor)
+	}))
+	defer RegisterFormatter(nil, nil)
+	defer RegisterFormatter(versionType, nil)
+
+	var nilVersion *testVersion
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{nil, "-"},
+		{nilVersion, "-"},
+		{testVersion{1, 2}, "v1.2"},
+		{&testVersion{3, 0}, "v3.0"},
+		{[]testVersion{{1, 0}, {1, 1}}, "v1.0, v1.1"},
+	}
+	for _, test := range tests {
+		if got := renderValue(test.value); got != test.want {
+			t.Errorf("Unexpected rendering of %#v; expected %q but got %q", test.value, test.want, got)
+		}
+	}
+
+	RegisterFormatter(versionType, nil)
+	if got := renderValue(testVersion{1, 2}); got != "{1 2}" {
+		t.Errorf("Formatter was not removed; got %q", got)
+	}
+}
+
+func TestFormatJoin(t *testing.T) {
+	join := FormatJoin(" | ")
+	if got := join([]int{1, 2, 3}); got != "1 | 2 | 3" {
+		t.Errorf("Unexpected join; got %q", got)
+	}
+	if got := join("single"); got != "single" {
+		t.Errorf("Unexpected join of non-slice; got %q", got)
+	}
+}
+
+func TestTableRegisterFormatter(t *testing.T) {
+	expected := "" +
+		"+---------+----------------+--------+\n" +
+		"| Service | Status         | Tags   |\n" +
+		"+---------+----------------+--------+\n" +
+		"| api     | UP             | a/b    |\n" +
+		"| db      | error: timeout | -      |\n" +
+		"| cache   | DOWN           | <none> |\n" +
+		"+---------+----------------+--------+\n"
+
+	RegisterFormatter(nil, FormatFunc(func(interface{}) string { return "-" }))
+	defer RegisterFormatter(nil, nil)
+
+	table := CreateTable()
+	table.AddHeaders("Service", "Status", "Tags")
+	table.AddRow("api", testState(1), []string{"a", "b"})
+	table.AddRow("db", errors.New("timeout"), nil)
+	table.AddRow("cache", testState(0), []string{})
+	table.RegisterFormatter(stateType, FormatFunc(func(v interface{}) string {
+		if v.(testState) == 0 {
+			return "DOWN"
+		}
+		return "UP"
+	}))
+	table.RegisterFormatter(errorType, FormatFunc(func(v interface{}) string {
+		return "error: " + v.(error).Error()
+	}))
+	table.RegisterFormatter(reflect.TypeOf([]string{}), FormatFunc(func(v interface{}) string {
+		if len(v.([]string)) == 0 {
+			return "<none>"
+		}
+		return strings.Join(v.([]string), "/")
+	}))
+	checkRendersTo(t, table, expected)
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"unicode"
+
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+// graphemeProperty is the Grapheme_Cluster_Break property of a rune, from
+// Unicode Standard Annex #29, "Unicode Text Segmentation"; Prepend is not
+// distinguished from Other.
+type graphemeProperty int
+
+const (
+	gbOther graphemeProperty = iota
+	gbCR
+	gbLF
+	gbControl
+	gbExtend
+	gbZWJ
+	gbRegionalIndicator
+	gbSpacingMark
+	gbL
+	gbV
+	gbT
+	gbLV
+	gbLVT
+)
+
+const (
+	zwnj = '\u200c'
+	zwj  = '\u200d'
+
+	// variation selectors asking for text and for emoji presentation
+	vs15 = '\ufe0e'
+	vs16 = '\ufe0f'
+)
+
+var (
+	// extendTable holds the runes which are Grapheme_Extend but not in the
+	// Mn or Me categories: emoji skin-tone modifiers and tag characters.
+	extendTable = &unicode.RangeTable{
+		R32: []unicode.Range32{
+			{Lo: 0x1f3fb, Hi: 0x1f3ff, Stride: 1},
+			{Lo: 0xe0020, Hi: 0xe007f, Stride: 1},
+		},
+	}
+
+	// extendedPictographicTable approximates the Extended_Pictographic
+	// property, from the Unicode emoji data, covering all emoji.
+	extendedPictographicTable = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x00a9, Hi: 0x00a9, Stride: 1},
+			{Lo: 0x00ae, Hi: 0x00ae, Stride: 1},
+			{Lo: 0x203c, Hi: 0x203c, Stride: 1},
+			{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+			{Lo: 0x2122, Hi: 0x2122, Stride: 1},
+			{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+			{Lo: 0x2194, Hi: 0x2199, Stride: 1},
+			{Lo: 0x21a9, Hi: 0x21aa, Stride: 1},
+			{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+			{Lo: 0x2328, Hi: 0x2328, Stride: 1},
+			{Lo: 0x2388, Hi: 0x2388, Stride: 1},
+			{Lo: 0x23cf, Hi: 0x23cf, Stride: 1},
+			{Lo: 0x23e9, Hi: 0x23f3, Stride: 1},
+			{Lo: 0x23f8, Hi: 0x23fa, Stride: 1},
+			{Lo: 0x24c2, Hi: 0x24c2, Stride: 1},
+			{Lo: 0x25aa, Hi: 0x25ab, Stride: 1},
+			{Lo: 0x25b6, Hi: 0x25b6, Stride: 1},
+			{Lo: 0x25c0, Hi: 0x25c0, Stride: 1},
+			{Lo: 0x25fb, Hi: 0x25fe, Stride: 1},
+			{Lo: 0x2600, Hi: 0x2605, Stride: 1},
+			{Lo: 0x2607, Hi: 0x2612, Stride: 1},
+			{Lo: 0x2614, Hi: 0x2685, Stride: 1},
+			{Lo: 0x2690, Hi: 0x2705, Stride: 1},
+			{Lo: 0x2708, Hi: 0x2712, Stride: 1},
+			{Lo: 0x2714, Hi: 0x2714, Stride: 1},
+			{Lo: 0x2716, Hi: 0x2716, Stride: 1},
+			{Lo: 0x271d, Hi: 0x271d, Stride: 1},
+			{Lo: 0x2721, Hi: 0x2721, Stride: 1},
+			{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+			{Lo: 0x2733, Hi: 0x2734, Stride: 1},
+			{Lo: 0x2744, Hi: 0x2744, Stride: 1},
+			{Lo: 0x2747, Hi: 0x2747, Stride: 1},
+			{Lo: 0x274c, Hi: 0x274c, Stride: 1},
+			{Lo: 0x274e, Hi: 0x274e, Stride: 1},
+			{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+			{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+			{Lo: 0x2763, Hi: 0x2767, Stride: 1},
+			{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+			{Lo: 0x27a1, Hi: 0x27a1, Stride: 1},
+			{Lo: 0x27b0, Hi: 0x27b0, Stride: 1},
+			{Lo: 0x27bf, Hi: 0x27bf, Stride: 1},
+			{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+			{Lo: 0x2b05, Hi: 0x2b07, Stride: 1},
+			{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+			{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+			{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+			{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+			{Lo: 0x303d, Hi: 0x303d, Stride: 1},
+			{Lo: 0x3297, Hi: 0x3297, Stride: 1},
+			{Lo: 0x3299, Hi: 0x3299, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x1f000, Hi: 0x1f0ff, Stride: 1},
+			{Lo: 0x1f10d, Hi: 0x1f10f, Stride: 1},
+			{Lo: 0x1f12f, Hi: 0x1f12f, Stride: 1},
+			{Lo: 0x1f16c, Hi: 0x1f171, Stride: 1},
+			{Lo: 0x1f17e, Hi: 0x1f17f, Stride: 1},
+			{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+			{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+			{Lo: 0x1f1ad, Hi: 0x1f1e5, Stride: 1},
+			{Lo: 0x1f201, Hi: 0x1f20f, Stride: 1},
+			{Lo: 0x1f21a, Hi: 0x1f21a, Stride: 1},
+			{Lo: 0x1f22f, Hi: 0x1f22f, Stride: 1},
+			{Lo: 0x1f232, Hi: 0x1f23a, Stride: 1},
+			{Lo: 0x1f23c, Hi: 0x1f23f, Stride: 1},
+			{Lo: 0x1f249, Hi: 0x1f3fa, Stride: 1},
+			{Lo: 0x1f400, Hi: 0x1f53d, Stride: 1},
+			{Lo: 0x1f546, Hi: 0x1f64f, Stride: 1},
+			{Lo: 0x1f680, Hi: 0x1f6ff, Stride: 1},
+			{Lo: 0x1f774, Hi: 0x1f77f, Stride: 1},
+			{Lo: 0x1f7d5, Hi: 0x1f7ff, Stride: 1},
+			{Lo: 0x1f80c, Hi: 0x1f80f, Stride: 1},
+			{Lo: 0x1f848, Hi: 0x1f84f, Stride: 1},
+			{Lo: 0x1f85a, Hi: 0x1f85f, Stride: 1},
+			{Lo: 0x1f888, Hi: 0x1f88f, Stride: 1},
+			{Lo: 0x1f8ae, Hi: 0x1f8ff, Stride: 1},
+			{Lo: 0x1f90c, Hi: 0x1f93a, Stride: 1},
+			{Lo: 0x1f93c, Hi: 0x1f945, Stride: 1},
+			{Lo: 0x1f947, Hi: 0x1faff, Stride: 1},
+			{Lo: 0x1fc00, Hi: 0x1fffd, Stride: 1},
+		},
+	}
+)
+
+// graphemePropertyOf returns the Grapheme_Cluster_Break property of r.
+func graphemePropertyOf(r rune) graphemeProperty {
+	switch {
+	case r == '\r':
+		return gbCR
+	case r == '\n':
+		return gbLF
+	case r == zwj:
+		return gbZWJ
+	case r == zwnj, unicode.In(r, unicode.Mn, unicode.Me, extendTable):
+		return gbExtend
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp):
+		return gbControl
+	case r >= 0x1f1e6 && r <= 0x1f1ff:
+		return gbRegionalIndicator
+	case unicode.Is(unicode.Mc, r):
+		return gbSpacingMark
+	case r >= 0x1100 && r <= 0x115f, r >= 0xa960 && r <= 0xa97c:
+		return gbL
+	case r >= 0x1160 && r <= 0x11a7, r >= 0xd7b0 && r <= 0xd7c6:
+		return gbV
+	case r >= 0x11a8 && r <= 0x11ff, r >= 0xd7cb && r <= 0xd7fb:
+		return gbT
+	case r >= 0xac00 && r <= 0xd7a3:
+		if (r-0xac00)%28 == 0 {
+			return gbLV
+		}
+		return gbLVT
+	}
+	return gbOther
+}
+
+// graphemeBreak reports whether there is a grapheme cluster boundary between
+// runes with properties prev and next, per the rules of UAX #29.  The state
+// which rules GB11 to GB13 need is passed in: whether prev is a ZWJ which
+// follows an Extended_Pictographic rune and any Extend runes, and whether an
+// odd number of Regional_Indicator runes end at prev.
+func graphemeBreak(prev, next graphemeProperty, nextPictographic, pictographicZWJ, oddRegional bool) bool {
+	switch {
+	case prev == gbCR && next == gbLF: // GB3
+		return false
+	case prev == gbCR, prev == gbLF, prev == gbControl: // GB4
+		return true
+	case next == gbCR, next == gbLF, next == gbControl: // GB5
+		return true
+	case prev == gbL && (next == gbL || next == gbV || next == gbLV || next == gbLVT): // GB6
+		return false
+	case (prev == gbLV || prev == gbV) && (next == gbV || next == gbT): // GB7
+		return false
+	case (prev == gbLVT || prev == gbT) && next == gbT: // GB8
+		return false
+	case next == gbExtend, next == gbZWJ, next == gbSpacingMark: // GB9, GB9a
+		return false
+	case pictographicZWJ && nextPictographic: // GB11
+		return false
+	case prev == gbRegionalIndicator && next == gbRegionalIndicator && oddRegional: // GB12, GB13
+		return false
+	}
+	return true // GB999
+}
+
+// stringWidth returns the number of tty character-cells needed to draw s,
+// which must not contain escape sequences.  The width is measured for each
+// extended grapheme cluster, so that combining marks, emoji ZWJ sequences,
+// skin-tone modifiers, flags and keycaps take up the cells of one glyph.
+// The condition controls the width of East Asian ambiguous characters.
+func stringWidth(cond *runewidth.Condition, s string) int {
+	width := 0
+	var (
+		prev             graphemeProperty
+		clusterStart     rune
+		clusterProperty  graphemeProperty
+		clusterEmoji     bool // a VS16 asks for emoji presentation
+		clusterText      bool // a VS15 asks for text presentation
+		pictographic     bool // Extended_Pictographic then any Extend
+		pictographicZWJ  bool
+		regionalRunCount int
+	)
+	for i, r := range s {
+		p := graphemePropertyOf(r)
+		pict := unicode.Is(extendedPictographicTable, r)
+		if i == 0 || graphemeBreak(prev, p, pict, pictographicZWJ, regionalRunCount%2 == 1) {
+			if i > 0 {
+				width += clusterWidth(cond, clusterStart, clusterProperty, clusterEmoji, clusterText)
+			}
+			clusterStart, clusterProperty = r, p
+			clusterEmoji, clusterText = false, false
+			regionalRunCount = 0
+		}
+
+		switch r {
+		case vs16:
+			clusterEmoji = true
+		case vs15:
+			clusterText = true
+		}
+		pictographicZWJ = p == gbZWJ && pictographic
+		if p != gbExtend {
+			pictographic = pict
+		}
+		if p == gbRegionalIndicator {
+			regionalRunCount++
+		} else {
+			regionalRunCount = 0
+		}
+		prev = p
+	}
+	if s != "" {
+		width += clusterWidth(cond, clusterStart, clusterProperty, clusterEmoji, clusterText)
+	}
+	return width
+}
+
+// clusterWidth returns the width of a grapheme cluster which starts with the
+// rune first, where emoji or text presentation may have been requested.
+func clusterWidth(cond *runewidth.Condition, first rune, p graphemeProperty, emoji, text bool) int {
+	if p == gbControl || p == gbCR || p == gbLF {
+		return 0
+	}
+	pictographic := unicode.Is(extendedPictographicTable, first)
+	w := cond.RuneWidth(first)
+	switch {
+	case pictographic && text:
+		return 1
+	case emoji && w < 2:
+		return 2
+	case pictographic && w == 0:
+		// emoji added to Unicode since our width tables were generated
+		return 2
+	}
+	return w
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func TestStringWidth(t *testing.T) {
+	tests := []struct {
+		in    string
+		width int
+	}{
+		{"", 0},
+		{"abc", 3},
+		{"noe\u0308l", 4}, // combining diaeresis
+		{"ｗｉｄｅ", 8},       // fullwidth
+		{"\U0001f468\u200d\U0001f469\u200d\U0001f467", 2}, // family ZWJ sequence
+		{"\U0001f44d\U0001f3fd", 2},                       // thumbs up, medium skin tone
+		{"\U0001f1fa\U0001f1f8", 2},                       // flag: US
+		{"\U0001f1fa\U0001f1f8\U0001f1ec\U0001f1e7", 4},   // flags: US, GB
+		{"1\ufe0f\u20e3", 2},                              // keycap 1
+		{"#\ufe0f\u20e3", 2},                              // keycap #
+		{"\u2764\ufe0f", 2},                               // heart, emoji presentation
+		{"\u2764", 1},                                     // heart, text presentation
+		{"\U0001f600\ufe0e", 1},                           // grinning face, text presentation
+		{"\U0001f9d1\u200d\U0001f4bb", 2},                 // technologist
+		{"\U0001f3f4\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", 2}, // flag: Scotland
+		{"\u1100\u1161\u11a8", 2}, // Hangul jamo
+		{"a\u2068b\u2069", 2},     // directional isolates
+		{"\r\n", 0},
+	}
+	for _, test := range tests {
+		if got := stringWidth(narrowCondition, test.in); got != test.width {
+			t.Errorf("Unexpected width for %+q; expected %d but got %d", test.in, test.width, got)
+		}
+	}
+}
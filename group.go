@@ -0,0 +1,100 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+// GroupOptions controls how GroupBy lays out each group of rows.  With
+// SuppressRepeats, the grouped column is left blank in each row after the
+// first of its group.  Subtotals gives, for columns counting from 1, the
+// aggregate to compute over each group in a subtotal row after it; the
+// subtotal row is labelled with SubtotalLabel, or "Subtotal", in the first
+// column without a subtotal.
+type GroupOptions struct {
+	SuppressRepeats bool
+	Subtotals       map[int]aggregate
+	SubtotalLabel   string
+}
+
+// GroupBy reorders the data rows of the table into groups with equal values
+// in a column, counting from 1, in the order in which each value first
+// appears; rows keep their order within a group.  Each group starts with a
+// row across the table showing the value, and groups are split by
+// separators; any separators, group headers and subtotals from before are
+// removed first.  The options may be nil.  Subtotals are computed when
+// GroupBy is called, so sort and filter the rows first.
+func (t *Table) GroupBy(column int, options *GroupOptions) {
+	if options == nil {
+		options = &GroupOptions{}
+	}
+
+	type group struct {
+		value interface{}
+		rows  []*Row
+	}
+	groups := []*group{}
+	key := SortKey{}
+	for _, e := range t.elements {
+		row, ok := e.(*Row)
+		if !ok || !row.isData() {
+			continue
+		}
+		v := row.value(column - 1)
+		var g *group
+		for _, candidate := range groups {
+			if key.compare(candidate.value, v) == 0 {
+				g = candidate
+				break
+			}
+		}
+		if g == nil {
+			g = &group{value: v}
+			groups = append(groups, g)
+		}
+		g.rows = append(g.rows, row)
+	}
+
+	elements := make([]Element, 0, len(t.elements)+3*len(groups))
+	for i, g := range groups {
+		if i > 0 {
+			elements = append(elements, &Separator{})
+		}
+		elements = append(elements, createHeaderRow([]interface{}{
+			CreateCell(g.value, &CellStyle{ColSpan: fullWidthSpan}),
+		}))
+		for _, row := range g.rows {
+			elements = append(elements, row)
+		}
+		if len(options.Subtotals) > 0 {
+			elements = append(elements, subtotalRow(g.rows, options))
+		}
+	}
+	t.elements = elements
+	t.suppressColumn = 0
+	if options.SuppressRepeats {
+		t.suppressColumn = column
+	}
+}
+
+// subtotalRow returns the row of subtotals for a group of rows.
+func subtotalRow(rows []*Row, options *GroupOptions) *Row {
+	label := options.SubtotalLabel
+	if label == "" {
+		label = "Subtotal"
+	}
+	last := 0
+	for column := range options.Subtotals {
+		if column > last {
+			last = column
+		}
+	}
+
+	row := &Row{cells: []*Cell{}, footer: true}
+	for column := 1; column <= last; column++ {
+		if agg, ok := options.Subtotals[column]; ok {
+			row.AddCell(computedCell(aggregateRows(rows, column, agg)))
+		} else {
+			row.AddCell(label)
+			label = ""
+		}
+	}
+	return row
+}
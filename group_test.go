@@ -0,0 +1,173 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func createGroupTable() *Table {
+	table := CreateTable()
+	table.AddHeaders("Region", "Item", "Stock")
+	table.AddRow("EU", "bolts", 120)
+	table.AddRow("US", "nuts", 40)
+	table.AddSeparator()
+	table.AddRow("EU", "nuts", 75)
+	table.AddRow("APAC", "bolts", 10)
+	table.AddRow("US", "washers", 300)
+	return table
+}
+
+func TestTableGroupBy(t *testing.T) {
+	expected := "" +
+		"+--------+---------+-------+\n" +
+		"| Region | Item    | Stock |\n" +
+		"+--------+---------+-------+\n" +
+		"| EU                       |\n" +
+		"| EU     | bolts   | 120   |\n" +
+		"| EU     | nuts    | 75    |\n" +
+		"+--------+---------+-------+\n" +
+		"| US                       |\n" +
+		"| US     | nuts    | 40    |\n" +
+		"| US     | washers | 300   |\n" +
+		"+--------+---------+-------+\n" +
+		"| APAC                     |\n" +
+		"| APAC   | bolts   | 10    |\n" +
+		"+--------+---------+-------+\n"
+
+	table := createGroupTable()
+	table.GroupBy(1, nil)
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableGroupBySubtotals(t *testing.T) {
+	expected := "" +
+		"+----------+---------+-------+\n" +
+		"| Region   | Item    | Stock |\n" +
+		"+----------+---------+-------+\n" +
+		"| bolts                      |\n" +
+		"| EU       | bolts   | 120   |\n" +
+		"| APAC     |         | 10    |\n" +
+		"| In stock |         | 130   |\n" +
+		"+----------+---------+-------+\n" +
+		"| nuts                       |\n" +
+		"| US       | nuts    | 40    |\n" +
+		"| EU       |         | 75    |\n" +
+		"| In stock |         | 115   |\n" +
+		"+----------+---------+-------+\n" +
+		"| washers                    |\n" +
+		"| US       | washers | 300   |\n" +
+		"| In stock |         | 300   |\n" +
+		"+----------+---------+-------+\n" +
+		"| Total    |         | 545   |\n" +
+		"+----------+---------+-------+\n"
+
+	table := createGroupTable()
+	table.AddFooter("Total", "", AggregateSum)
+	table.SortBy(2, SortAscending)
+	table.GroupBy(2, &GroupOptions{
+		SuppressRepeats: true,
+		Subtotals:       map[int]aggregate{3: AggregateSum},
+		SubtotalLabel:   "In stock",
+	})
+	checkRendersTo(t, table, expected)
+
+	// Grouping again replaces the earlier group headers and subtotals.
+	table.GroupBy(2, &GroupOptions{
+		SuppressRepeats: true,
+		Subtotals:       map[int]aggregate{3: AggregateSum},
+		SubtotalLabel:   "In stock",
+	})
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableGroupBySuppressRepeatsAfterChanges(t *testing.T) {
+	expected := "" +
+		"+--------+---------+-------+\n" +
+		"| Region | Item    | Stock |\n" +
+		"+--------+---------+-------+\n" +
+		"| EU                       |\n" +
+		"| EU     | bolts   | 120   |\n" +
+		"|        | nuts    | 75    |\n" +
+		"+--------+---------+-------+\n" +
+		"| US                       |\n" +
+		"| US     | washers | 300   |\n" +
+		"|        | nuts    | 40    |\n" +
+		"+--------+---------+-------+\n" +
+		"| APAC                     |\n" +
+		"| APAC   | bolts   | 10    |\n" +
+		"+--------+---------+-------+\n"
+
+	table := createGroupTable()
+	table.GroupBy(1, &GroupOptions{SuppressRepeats: true})
+	table.SortBy(3, SortDescending)
+	checkRendersTo(t, table, expected)
+
+	expected = "" +
+		"+--------+---------+-------+\n" +
+		"| Region | Item    | Stock |\n" +
+		"+--------+---------+-------+\n" +
+		"| bolts                    |\n" +
+		"| EU     | bolts   | 120   |\n" +
+		"| APAC   |         | 10    |\n" +
+		"+--------+---------+-------+\n" +
+		"| nuts                     |\n" +
+		"| EU     | nuts    | 75    |\n" +
+		"| US     |         | 40    |\n" +
+		"+--------+---------+-------+\n" +
+		"| washers                  |\n" +
+		"| US     | washers | 300   |\n" +
+		"+--------+---------+-------+\n"
+
+	table.GroupBy(2, &GroupOptions{SuppressRepeats: true})
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableGroupByWithViews(t *testing.T) {
+	table := createGroupTable()
+	narrowAmbiguous(table)
+	table.GroupBy(1, &GroupOptions{SuppressRepeats: true, Subtotals: map[int]aggregate{3: AggregateSum}})
+
+	expected := "" +
+		"+----------+-------+-------+\n" +
+		"| Region   | Item  | Stock |\n" +
+		"+----------+-------+-------+\n" +
+		"| EU                       |\n" +
+		"| EU       | nuts  | 75    |\n" +
+		"| Subtotal |       | 195   |\n" +
+		"+----------+-------+-------+\n" +
+		"| US                       |\n" +
+		"| US       | nuts  | 40    |\n" +
+		"| Subtotal |       | 340   |\n" +
+		"+----------+-------+-------+\n" +
+		"| APAC                     |\n" +
+		"| APAC     | bolts | 10    |\n" +
+		"| Subtotal |       | 10    |\n" +
+		"+----------+-------+-------+\n"
+	checkRendersTo(t, table.Filter(func(r *Row) bool { return r.Value(3).(int) < 100 }), expected)
+
+	expected = "" +
+		"+----------+---------+-------+\n" +
+		"| Region   | Item    | Stock |\n" +
+		"+----------+---------+-------+\n" +
+		"| US                         |\n" +
+		"| US       | nuts    | 40    |\n" +
+		"|          | washers | 300   |\n" +
+		"| Subtotal |         | 340   |\n" +
+		"+----------+---------+-------+\n"
+	checkRendersTo(t, table.Page(2, 2), expected)
+
+	expected = "" +
+		"+----------+-------+-------+\n" +
+		"| Region   | Item  | Stock |\n" +
+		"+----------+-------+-------+\n" +
+		"| EU                       |\n" +
+		"| EU       | bolts | 120   |\n" +
+		"|     … 3 more rows …      |\n" +
+		"| APAC                     |\n" +
+		"| APAC     | bolts | 10    |\n" +
+		"| Subtotal |       | 10    |\n" +
+		"+----------+-------+-------+\n"
+	table.SetRowLimit(1, 1)
+	checkRendersTo(t, table, expected)
+}
@@ -37,7 +37,40 @@ func (r *Row) HTML(tag string, style *renderStyle) string {
 				attrs[i] = " align='right'"
 			}
 		}
-		elems[i] = html.EscapeString(strings.TrimSpace(r.cells[i].Render(style)))
+		css := style.textStyle(r, r.cells[i]).css()
+		// Decimal alignment, like in alignCell, only applies to numbers in
+		// cells of one column outside the headers; other cells keep to the
+		// left.
+		decimalPad := -1
+		if r.cells[i].effectiveAlignment(style) == AlignDecimal {
+			_, right, ok := style.decimalSplit(r.cells[i].content(style, r), r.cells[i].column)
+			if ok && !r.header && r.cells[i].colSpan <= 1 {
+				// Pad the fractional part with figure spaces, which are as
+				// wide as digits in tabular figures, then right-align.
+				decimalPad = style.decimalWidths[r.cells[i].column].right - right
+				attrs[i] = " align='right'"
+				css = append(css, "font-variant-numeric: tabular-nums")
+			}
+		}
+		if span := r.cells[i].colSpan; span > 1 {
+			if span > style.columns {
+				span = style.columns
+			}
+			attrs[i] += fmt.Sprintf(" colspan='%d'", span)
+		}
+		if style.IsolateBidi {
+			attrs[i] += " dir='auto'"
+		}
+		if len(css) > 0 {
+			attrs[i] += " style='" + strings.Join(css, "; ") + "'"
+		}
+		elems[i] = html.EscapeString(strings.TrimSpace(r.cells[i].render(style, r)))
+		if decimalPad > 0 {
+			elems[i] += strings.Repeat("&#x2007;", decimalPad)
+		}
+		if link := r.cells[i].link; link != "" && safeLinkURL(link) {
+			elems[i] = "<a href='" + html.EscapeString(link) + "'>" + elems[i] + "</a>"
+		}
 	}
 	// WAG as to max capacity, plus a bit
 	buf := bytes.NewBuffer(make([]byte, 0, 8192))
@@ -72,9 +105,16 @@ func generateHtmlTitleRow(title interface{}, t *Table, style *renderStyle) strin
 // plain text and rely upon HTML ignoring extra whitespace.
 func (t *Table) RenderHTML() (buffer string) {
 	// elements is already populated with row data
+	elements := t.limitedElements()
+	footer := t.footerRow()
 
-	// generate the runtime style
-	style := createRenderStyle(t)
+	// generate the runtime style, from all of the rows to be drawn
+	tt := t.clone()
+	tt.elements = elements
+	if footer != nil {
+		tt.elements = append(tt.elements[:len(elements):len(elements)], footer)
+	}
+	style := createRenderStyle(tt)
 	style.PaddingLeft = 0
 	style.PaddingRight = 0
 
@@ -87,15 +127,17 @@ func (t *Table) RenderHTML() (buffer string) {
 			rowsText = append(rowsText, generateHtmlTitleRow(t.title, t, style))
 		}
 		if t.headers != nil {
-			rowsText = append(rowsText, CreateRow(t.headers).HTML("th", style))
+			headerRow := t.columnHeaderRow()
+			headerRow.SetStyle(t.Style.HeaderTextStyle)
+			rowsText = append(rowsText, headerRow.HTML("th", style))
 		}
 		rowsText = append(rowsText, "</thead>\n")
 	}
 
 	rowsText = append(rowsText, "<tbody>\n")
 	// loop over the elements and render them
-	for i := range t.elements {
-		if row, ok := t.elements[i].(*Row); ok {
+	for i := range elements {
+		if row, ok := elements[i].(*Row); ok {
 			rowsText = append(rowsText, row.HTML("td", style))
 		} else {
 			rowsText = append(rowsText, fmt.Sprintf("<!-- unable to render line %d, unhandled type -->\n", i))
@@ -103,5 +145,9 @@ func (t *Table) RenderHTML() (buffer string) {
 	}
 	rowsText = append(rowsText, "</tbody>\n")
 
+	if footer != nil {
+		rowsText = append(rowsText, "<tfoot>\n", footer.HTML("td", style), "</tfoot>\n")
+	}
+
 	return "<table class=\"termtable\">\n" + strings.Join(rowsText, "") + "</table>\n"
 }
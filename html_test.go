@@ -220,3 +220,27 @@ func TestTableWithAltTitleStyle(t *testing.T) {
 		t.Fatal(DisplayFailedOutput(output, expected))
 	}
 }
+
+func TestTableStylesAsCSS(t *testing.T) {
+	expected := "<table class=\"termtable\">\n" +
+		"<thead>\n" +
+		"<tr><th>Name</th><th>State</th></tr>\n" +
+		"</thead>\n" +
+		"<tbody>\n" +
+		"<tr><td>web</td><td style='color: #00cd00'>up</td></tr>\n" +
+		"<tr><td style='font-weight: bold'>db</td><td style='color: #cd0000; font-weight: bold'>down</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+
+	table := CreateTable()
+	table.SetModeHTML()
+	table.AddHeaders("Name", "State")
+	table.AddRow("web", "up")
+	table.AddRow("db", CreateCell("down", &CellStyle{TextStyle: TextStyle{Foreground: ColorRed}})).SetStyle(TextStyle{Bold: true})
+	table.SetColumnStyle(2, TextStyle{Foreground: ColorGreen})
+
+	output := table.Render()
+	if output != expected {
+		t.Fatal(DisplayFailedOutput(output, expected))
+	}
+}
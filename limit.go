@@ -0,0 +1,111 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+// fullWidthSpan is the column span given to cells which should stretch
+// across the whole table, such as the title; the table is widened to fit
+// them if needed.
+const fullWidthSpan = 999
+
+// Page returns a new Table holding page n, counting from 1, of the data rows
+// of this one, where each page holds size rows; as with Filter, the headers,
+// title and settings are kept, and so are separators, group headers and
+// subtotals around the rows.  A page past the end holds no rows.
+func (t *Table) Page(n, size int) *Table {
+	first, last := (n-1)*size, n*size
+	i := 0
+	return t.Filter(func(*Row) bool {
+		i++
+		return i > first && i <= last
+	})
+}
+
+// SetRowLimit limits the data rows drawn, in every output mode, to the first
+// head and the last tail of them; if there are more, the rest are replaced
+// by a row across the table saying how many were left out, such as
+// "… 4,812 more rows …".  Setting both to zero removes the limit.
+func (t *Table) SetRowLimit(head, tail int) {
+	if head < 0 {
+		head = 0
+	}
+	if tail < 0 {
+		tail = 0
+	}
+	t.rowLimit.head, t.rowLimit.tail = head, tail
+}
+
+// limitedElements returns the elements of the table to draw, taking into
+// account any row limit, which counts only the data rows.  Any separators
+// amongst the rows left out are left out too, as are group headers before
+// them and subtotals after them.
+func (t *Table) limitedElements() []Element {
+	head, tail := t.rowLimit.head, t.rowLimit.tail
+	if head == 0 && tail == 0 {
+		return t.elements
+	}
+
+	rows := 0
+	for _, e := range t.elements {
+		if row, ok := e.(*Row); ok && row.isData() {
+			rows++
+		}
+	}
+	elided := rows - head - tail
+	if elided <= 0 {
+		return t.elements
+	}
+
+	// kept reports whether the i'th row, counting from 1, is drawn; the
+	// start and end of the table, as rows 0 and rows+1, always are.
+	kept := func(i int) bool {
+		return i <= head || i > head+elided
+	}
+	elements := make([]Element, 0, head+tail+1)
+	row := 0
+	for _, e := range t.elements {
+		r, ok := e.(*Row)
+		switch {
+		case !ok:
+			if kept(row) && kept(row+1) {
+				elements = append(elements, e)
+			}
+			continue
+		case r.header:
+			if kept(row + 1) {
+				elements = append(elements, e)
+			}
+			continue
+		case !r.isData():
+			if kept(row) {
+				elements = append(elements, e)
+			}
+			continue
+		}
+		row++
+		if kept(row) {
+			elements = append(elements, e)
+		} else if row == head+1 {
+			elements = append(elements, elidedRow(elided))
+		}
+	}
+	return elements
+}
+
+// elidedRow returns the row drawn in place of n rows left out of a table.
+func elidedRow(n int) *Row {
+	text := "… " + renderCount(n) + " more rows …"
+	if n == 1 {
+		text = "… 1 more row …"
+	}
+	row := createHeaderRow([]interface{}{
+		CreateCell(text, &CellStyle{Alignment: AlignCenter, ColSpan: fullWidthSpan}),
+	})
+	row.elided = n
+	return row
+}
+
+// renderCount returns n with its digits grouped by commas.
+func renderCount(n int) string {
+	text, _ := NumberFormat{ThousandsSeparator: ","}.format(n)
+	return text
+}
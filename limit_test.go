@@ -0,0 +1,122 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func createLimitTable(rows int) *Table {
+	table := CreateTable()
+	table.AddHeaders("N", "Square")
+	for i := 1; i <= rows; i++ {
+		table.AddRow(i, i*i)
+		if i == 2 {
+			table.AddSeparator()
+		}
+	}
+	return table
+}
+
+func TestTablePage(t *testing.T) {
+	expected := "" +
+		"+---+--------+\n" +
+		"| N | Square |\n" +
+		"+---+--------+\n" +
+		"| 1 | 1      |\n" +
+		"| 2 | 4      |\n" +
+		"+---+--------+\n" +
+		"| 3 | 9      |\n" +
+		"+---+--------+\n"
+
+	table := createLimitTable(5)
+	checkRendersTo(t, table.Page(1, 3), expected)
+
+	expected = "" +
+		"+---+--------+\n" +
+		"| N | Square |\n" +
+		"+---+--------+\n" +
+		"| 3 | 9      |\n" +
+		"| 4 | 16     |\n" +
+		"+---+--------+\n"
+	checkRendersTo(t, table.Page(2, 2), expected)
+
+	if n := len(table.Page(4, 2).elements); n != 0 {
+		t.Errorf("Page past the end has %d elements", n)
+	}
+}
+
+func TestTableRowLimit(t *testing.T) {
+	expected := "" +
+		"+------+--------------+\n" +
+		"| N    | Square       |\n" +
+		"+------+--------------+\n" +
+		"| 1    | 1            |\n" +
+		"| … 4,996 more rows … |\n" +
+		"| 4998 | 24980004     |\n" +
+		"| 4999 | 24990001     |\n" +
+		"| 5000 | 25000000     |\n" +
+		"+------+--------------+\n"
+
+	table := createLimitTable(5000)
+	narrowAmbiguous(table)
+	table.SetRowLimit(1, 3)
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableRowLimitKeepsSeparators(t *testing.T) {
+	expected := "" +
+		"+---+------------+\n" +
+		"| N | Square     |\n" +
+		"+---+------------+\n" +
+		"| 1 | 1          |\n" +
+		"| 2 | 4          |\n" +
+		"+---+------------+\n" +
+		"| 3 | 9          |\n" +
+		"| … 1 more row … |\n" +
+		"+---+------------+\n"
+
+	table := createLimitTable(4)
+	narrowAmbiguous(table)
+	table.SetRowLimit(3, 0)
+	checkRendersTo(t, table, expected)
+
+	table.SetRowLimit(4, 0)
+	if n := len(table.limitedElements()); n != 5 {
+		t.Errorf("Rows within the limit were changed; got %d elements", n)
+	}
+}
+
+func TestTableRowLimitInMarkdown(t *testing.T) {
+	expected := "" +
+		"| N | Square      |\n" +
+		"| - | ----------- |\n" +
+		"| 1 | 1           |\n" +
+		"| … 3 more rows … |\n" +
+		"| 5 | 25          |\n"
+
+	table := createLimitTable(5)
+	narrowAmbiguous(table)
+	table.SetModeMarkdown()
+	table.SetRowLimit(1, 1)
+	checkRendersTo(t, table, expected)
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableRowLimitHTML(t *testing.T) {
+	expected := "<table class=\"termtable\">\n" +
+		"<thead>\n" +
+		"<tr><th>N</th><th>Square</th></tr>\n" +
+		"</thead>\n" +
+		"<tbody>\n" +
+		"<tr><td>1</td><td>1</td></tr>\n" +
+		"<tr><td align='center' colspan='2'>… 3 more rows …</td></tr>\n" +
+		"<tr><td>5</td><td>25</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+
+	table := createLimitTable(5)
+	table.SetModeHTML()
+	table.SetRowLimit(1, 1)
+	checkRendersTo(t, table, expected)
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// A Hyperlink is a cell value which shows Text, linked to URL.  In terminal
+// output this uses OSC 8 escape sequences, where the terminal can show
+// colour; in HTML it is an <a> element and in Markdown it is [Text](URL).
+// Only the Text counts towards the width of the cell.  Control characters in
+// the URL are percent-encoded in terminal output, so that they cannot end
+// the escape sequence early, and so are the characters which would end the
+// link in Markdown.  As HTML and Markdown are read in browsers, they only
+// link to http, https and mailto URLs, or relative ones; with any other
+// scheme, such as javascript:, the Text is shown without the link.
+type Hyperlink struct {
+	Text string
+	URL  string
+}
+
+// Link returns a Hyperlink to be used as the value of a cell.
+func Link(text, url string) Hyperlink {
+	return Hyperlink{Text: text, URL: url}
+}
+
+// String returns the text of the link.
+func (h Hyperlink) String() string {
+	return h.Text
+}
+
+// escapeLinkURL returns url with its control characters, C0, DEL and C1,
+// percent-encoded, for use within an OSC 8 escape sequence.
+func escapeLinkURL(url string) string {
+	return percentEncode(url, isControl)
+}
+
+// markdownLink returns a Markdown link to url showing text, with the
+// brackets in text escaped and the characters in url which would end the
+// link, or are not allowed in it, percent-encoded.
+func markdownLink(text, url string) string {
+	text = strings.NewReplacer(`\`, `\\`, "[", `\[`, "]", `\]`).Replace(text)
+	url = percentEncode(url, func(r rune) bool {
+		return isControl(r) || strings.ContainsRune(" ()<>\\", r)
+	})
+	return "[" + text + "](" + url + ")"
+}
+
+// safeLinkURL reports whether url may be linked to in HTML or Markdown: it
+// must be relative, or use the http, https or mailto scheme.
+func safeLinkURL(link string) bool {
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	switch u.Scheme {
+	case "", "http", "https", "mailto":
+		return true
+	}
+	return false
+}
+
+// isControl reports whether r is a control character, C0, DEL or C1.
+func isControl(r rune) bool {
+	return r < 0x20 || r == 0x7f || (r >= 0x80 && r <= 0x9f)
+}
+
+// percentEncode returns s with the UTF-8 bytes of each rune for which
+// encode is true percent-encoded.
+func percentEncode(s string, encode func(rune) bool) string {
+	if strings.IndexFunc(s, encode) < 0 {
+		return s
+	}
+	var b strings.Builder
+	for _, r := range s {
+		if !encode(r) {
+			b.WriteRune(r)
+			continue
+		}
+		for _, c := range []byte(string(r)) {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+
+	"github.com/apcera/termtables/term"
+)
+
+func createLinkTable() *Table {
+	table := CreateTable()
+	table.AddHeaders("Issue", "State")
+	table.AddRow(Link("#33", "https://example.com/issues/33"), "open")
+	table.AddRow("#1234", "closed")
+	return table
+}
+
+func TestTableWithLinks(t *testing.T) {
+	expected := "" +
+		"+-------+--------+\n" +
+		"| Issue | State  |\n" +
+		"+-------+--------+\n" +
+		"| \033]8;;https://example.com/issues/33\033\\#33\033]8;;\033\\   | open   |\n" +
+		"| #1234 | closed |\n" +
+		"+-------+--------+\n"
+
+	table := createLinkTable()
+	table.SetColorDepth(term.TrueColor)
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableWithLinksEscapesControls(t *testing.T) {
+	expected := "" +
+		"+------+\n" +
+		"| \033]8;;https://example.com/%1B]0;pwned%07%C2%9C\033\\link\033]8;;\033\\ |\n" +
+		"+------+\n"
+
+	table := CreateTable()
+	table.SetColorDepth(term.TrueColor)
+	table.AddRow(Link("link", "https://example.com/\033]0;pwned\a\u009c"))
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableWithLinksNoColor(t *testing.T) {
+	expected := "" +
+		"+-------+--------+\n" +
+		"| Issue | State  |\n" +
+		"+-------+--------+\n" +
+		"| #33   | open   |\n" +
+		"| #1234 | closed |\n" +
+		"+-------+--------+\n"
+
+	table := createLinkTable()
+	table.SetColorDepth(term.NoColor)
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableWithLinksInMarkdown(t *testing.T) {
+	expected := "" +
+		"| Issue                                | State  |\n" +
+		"| ------------------------------------ | ------ |\n" +
+		"| [#33](https://example.com/issues/33) | open   |\n" +
+		"| #1234                                | closed |\n"
+
+	table := createLinkTable()
+	table.SetModeMarkdown()
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableWithLinksInHTML(t *testing.T) {
+	expected := "<table class=\"termtable\">\n" +
+		"<thead>\n" +
+		"<tr><th>Issue</th><th>State</th></tr>\n" +
+		"</thead>\n" +
+		"<tbody>\n" +
+		"<tr><td><a href='https://example.com/issues/33?a=1&amp;b=2'>#33</a></td><td>open</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+
+	table := CreateTable()
+	table.SetModeHTML()
+	table.AddHeaders("Issue", "State")
+	table.AddRow(Link("#33", "https://example.com/issues/33?a=1&b=2"), "open")
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableWithLinksInMarkdownEscapes(t *testing.T) {
+	expected := "" +
+		"| Issue                                                          |\n" +
+		"| -------------------------------------------------------------- |\n" +
+		"| [\\[draft\\] #33](https://example.com/a%20b%29%3Cc%3E?q=%28x%29) |\n"
+
+	table := CreateTable()
+	table.SetModeMarkdown()
+	table.AddHeaders("Issue")
+	table.AddRow(Link("[draft] #33", "https://example.com/a b)<c>?q=(x)"))
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableWithUnsafeLinks(t *testing.T) {
+	expected := "<table class=\"termtable\">\n" +
+		"<thead>\n" +
+		"<tr><th>Link</th></tr>\n" +
+		"</thead>\n" +
+		"<tbody>\n" +
+		"<tr><td>run</td></tr>\n" +
+		"<tr><td>data</td></tr>\n" +
+		"<tr><td><a href='mailto:ops@example.com'>mail</a></td></tr>\n" +
+		"<tr><td><a href='/issues/33'>#33</a></td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+
+	table := CreateTable()
+	table.AddHeaders("Link")
+	table.AddRow(Link("run", "JavaScript:alert(1)"))
+	table.AddRow(Link("data", "data:text/html,<script>alert(1)</script>"))
+	table.AddRow(Link("mail", "mailto:ops@example.com"))
+	table.AddRow(Link("#33", "/issues/33"))
+	table.SetModeHTML()
+	checkRendersTo(t, table, expected)
+
+	expected = "" +
+		"| Link                           |\n" +
+		"| ------------------------------ |\n" +
+		"| run                            |\n" +
+		"| data                           |\n" +
+		"| [mail](mailto:ops@example.com) |\n" +
+		"| [#33](/issues/33)              |\n"
+	table.SetModeMarkdown()
+	checkRendersTo(t, table, expected)
+}
@@ -0,0 +1,175 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// A NumberFormat describes how numeric cell values (the built-in integer and
+// floating-point types) are turned into text.  It can be set for a whole
+// Table with SetNumberFormat or for one column with SetColumnNumberFormat;
+// header rows are never affected.
+//
+// Precision is the number of digits drawn after the decimal mark for
+// floating-point values; a negative Precision draws the fewest digits which
+// represent the value exactly.  If SignificantDigits is non-zero, values are
+// instead rounded to that many significant digits.  Integers are drawn
+// exactly unless Percent or scientific notation apply.
+//
+// ThousandsSeparator, if non-empty, is inserted between each group of three
+// digits in the integer part; DecimalMark replaces the '.' before the
+// fraction, for locales which use, eg, a comma.  Values whose magnitude is at
+// least ScientificAbove, or non-zero and below ScientificBelow, are drawn in
+// scientific notation; either threshold is ignored when zero.
+//
+// Percent multiplies the value by 100 and appends a '%' sign.  Accounting
+// draws negative values within parentheses instead of with a minus sign.
+type NumberFormat struct {
+	Precision          int
+	SignificantDigits  int
+	ThousandsSeparator string
+	DecimalMark        rune
+	ScientificAbove    float64
+	ScientificBelow    float64
+	Percent            bool
+	Accounting         bool
+}
+
+// DefaultNumberFormat is the format used for numbers in cells when no other
+// has been set: two decimal places for floating-point values.
+var DefaultNumberFormat = NumberFormat{Precision: 2}
+
+// numberValue returns v as a float64 if it is of one of the built-in numeric
+// types, together with the exact decimal text of v if it is an integer and
+// the bit size to use in formatting it as a float.
+func numberValue(v interface{}) (f float64, integer string, bits int, ok bool) {
+	switch vv := v.(type) {
+	case int:
+		return float64(vv), strconv.FormatInt(int64(vv), 10), 64, true
+	case int8:
+		return float64(vv), strconv.FormatInt(int64(vv), 10), 64, true
+	case int16:
+		return float64(vv), strconv.FormatInt(int64(vv), 10), 64, true
+	case int32:
+		return float64(vv), strconv.FormatInt(int64(vv), 10), 64, true
+	case int64:
+		return float64(vv), strconv.FormatInt(vv, 10), 64, true
+	case uint:
+		return float64(vv), strconv.FormatUint(uint64(vv), 10), 64, true
+	case uint8:
+		return float64(vv), strconv.FormatUint(uint64(vv), 10), 64, true
+	case uint16:
+		return float64(vv), strconv.FormatUint(uint64(vv), 10), 64, true
+	case uint32:
+		return float64(vv), strconv.FormatUint(uint64(vv), 10), 64, true
+	case uint64:
+		return float64(vv), strconv.FormatUint(vv, 10), 64, true
+	case float32:
+		return float64(vv), "", 32, true
+	case float64:
+		return vv, "", 64, true
+	}
+	return 0, "", 0, false
+}
+
+// format returns v drawn in this format, and false if v is not a number.
+func (nf NumberFormat) format(v interface{}) (string, bool) {
+	f, integer, bits, ok := numberValue(v)
+	if !ok {
+		return "", false
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return strconv.FormatFloat(f, 'f', -1, bits), true
+	}
+
+	if nf.Percent {
+		f *= 100
+		integer = ""
+	}
+	abs := math.Abs(f)
+	scientific := (nf.ScientificAbove > 0 && abs >= nf.ScientificAbove) ||
+		(nf.ScientificBelow > 0 && abs != 0 && abs < nf.ScientificBelow)
+
+	var text string
+	switch {
+	case scientific:
+		prec := nf.Precision
+		if nf.SignificantDigits > 0 {
+			prec = nf.SignificantDigits - 1
+		}
+		text = strconv.FormatFloat(abs, 'e', prec, bits)
+	case integer != "":
+		text = strings.TrimPrefix(integer, "-")
+	case nf.SignificantDigits > 0:
+		text = formatSignificant(abs, nf.SignificantDigits, bits)
+	default:
+		text = strconv.FormatFloat(abs, 'f', nf.Precision, bits)
+	}
+
+	text = nf.punctuate(text)
+	if nf.Percent {
+		text += "%"
+	}
+	if f < 0 && strings.IndexFunc(text, func(r rune) bool { return r >= '1' && r <= '9' }) >= 0 {
+		if nf.Accounting {
+			text = "(" + text + ")"
+		} else {
+			text = "-" + text
+		}
+	}
+	return text, true
+}
+
+// formatSignificant returns the non-negative value f rounded to digits
+// significant digits, without an exponent.
+func formatSignificant(f float64, digits, bits int) string {
+	rounded, err := strconv.ParseFloat(strconv.FormatFloat(f, 'e', digits-1, bits), 64)
+	if err != nil || rounded == 0 {
+		return strconv.FormatFloat(0, 'f', digits-1, bits)
+	}
+	prec := digits - 1 - int(math.Floor(math.Log10(rounded)))
+	if prec < 0 {
+		prec = 0
+	}
+	return strconv.FormatFloat(rounded, 'f', prec, bits)
+}
+
+// punctuate inserts the thousands separator into the integer part of the
+// unsigned number text and replaces its decimal point with the decimal mark.
+func (nf NumberFormat) punctuate(text string) string {
+	end := strings.IndexAny(text, ".e")
+	if end < 0 {
+		end = len(text)
+	}
+	intPart, rest := text[:end], text[end:]
+
+	if nf.ThousandsSeparator != "" && len(intPart) > 3 {
+		var b strings.Builder
+		for i, r := range intPart {
+			if i > 0 && (len(intPart)-i)%3 == 0 {
+				b.WriteString(nf.ThousandsSeparator)
+			}
+			b.WriteRune(r)
+		}
+		intPart = b.String()
+	}
+	if nf.DecimalMark != 0 && nf.DecimalMark != '.' {
+		rest = strings.Replace(rest, ".", string(nf.DecimalMark), 1)
+	}
+	return intPart + rest
+}
+
+// numberFormat returns the NumberFormat which applies to a cell in a row,
+// or nil if the cell's text is drawn as it was created.
+func (s *renderStyle) numberFormat(r *Row, c *Cell) *NumberFormat {
+	if cc := s.columnConfig(r, c); cc != nil && cc.numberFormat != nil {
+		return cc.numberFormat
+	}
+	if r == nil || !r.columnSettings(c) {
+		return nil
+	}
+	return s.tableNumberFormat
+}
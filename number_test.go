@@ -0,0 +1,126 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNumberFormat(t *testing.T) {
+	tests := []struct {
+		format NumberFormat
+		value  interface{}
+		want   string
+	}{
+		{DefaultNumberFormat, 3.14159, "3.14"},
+		{DefaultNumberFormat, 0.125, "0.12"},
+		{DefaultNumberFormat, float32(1.5), "1.50"},
+		{DefaultNumberFormat, int32(-42), "-42"},
+		{DefaultNumberFormat, uint8(255), "255"},
+		{DefaultNumberFormat, uint64(math.MaxUint64), "18446744073709551615"},
+		{NumberFormat{Precision: -1}, 0.1, "0.1"},
+		{NumberFormat{Precision: -1}, float32(0.1), "0.1"},
+		{NumberFormat{Precision: 0}, 2.5, "2"},
+		{NumberFormat{SignificantDigits: 3}, 1234.5678, "1230"},
+		{NumberFormat{SignificantDigits: 3}, 0.0012345, "0.00123"},
+		{NumberFormat{SignificantDigits: 3}, 9.999, "10.0"},
+		{NumberFormat{SignificantDigits: 2}, 0.0, "0.0"},
+		{NumberFormat{Precision: 2, ThousandsSeparator: ","}, 1234567.891, "1,234,567.89"},
+		{NumberFormat{Precision: 2, ThousandsSeparator: ","}, -1234, "-1,234"},
+		{NumberFormat{Precision: 2, ThousandsSeparator: ","}, 999, "999"},
+		{NumberFormat{Precision: 2, ThousandsSeparator: ".", DecimalMark: ','}, 1234.5, "1.234,50"},
+		{NumberFormat{Precision: 1, ThousandsSeparator: " ", DecimalMark: ','}, 12345.67, "12 345,7"},
+		{NumberFormat{Precision: 2, ScientificAbove: 1e6}, 12345678.0, "1.23e+07"},
+		{NumberFormat{Precision: 2, ScientificAbove: 1e6}, 999999, "999999"},
+		{NumberFormat{Precision: 2, ScientificAbove: 1e6}, 1000000, "1.00e+06"},
+		{NumberFormat{Precision: 2, ScientificBelow: 1e-3}, 0.000012, "1.20e-05"},
+		{NumberFormat{Precision: 2, ScientificBelow: 1e-3}, 0.0, "0.00"},
+		{NumberFormat{Precision: 2, DecimalMark: ',', ScientificBelow: 1e-3}, -0.000012, "-1,20e-05"},
+		{NumberFormat{Precision: 1, Percent: true}, 0.256, "25.6%"},
+		{NumberFormat{Precision: 0, Percent: true}, 1, "100%"},
+		{NumberFormat{Precision: 2, Accounting: true}, -1234.5, "(1234.50)"},
+		{NumberFormat{Precision: 2, Accounting: true, ThousandsSeparator: ","}, -1234, "(1,234)"},
+		{NumberFormat{Precision: 2, Accounting: true}, 12.0, "12.00"},
+		{NumberFormat{Precision: 1, Accounting: true, Percent: true}, -0.05, "(5.0%)"},
+		{NumberFormat{Precision: 2}, -0.001, "0.00"},
+		{NumberFormat{Precision: 2}, math.Inf(-1), "-Inf"},
+		{NumberFormat{Precision: 2}, math.NaN(), "NaN"},
+	}
+	for _, test := range tests {
+		got, ok := test.format.format(test.value)
+		if !ok {
+			t.Errorf("Value %#v was not treated as a number", test.value)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("Unexpected formatting of %#v with %+v; expected %q but got %q", test.value, test.format, test.want, got)
+		}
+	}
+}
+
+func TestNumberFormatIgnoresOtherTypes(t *testing.T) {
+	for _, v := range []interface{}{"12", true, nil, []int{1}, Link("1", "u")} {
+		if got, ok := DefaultNumberFormat.format(v); ok {
+			t.Errorf("Value %#v was treated as a number, giving %q", v, got)
+		}
+	}
+}
+
+func createNumberTable() *Table {
+	table := CreateTable()
+	table.AddHeaders("Item", 2024)
+	table.AddRow("rent", -1250.5)
+	table.AddRow("salary", 4200)
+	table.AddRow("share", 0.126)
+	return table
+}
+
+func TestTableNumberFormat(t *testing.T) {
+	expected := "" +
+		"+--------+------------+\n" +
+		"| Item   | 2024       |\n" +
+		"+--------+------------+\n" +
+		"| rent   | (1,250.50) |\n" +
+		"| salary | 4,200      |\n" +
+		"| share  | 0.13       |\n" +
+		"+--------+------------+\n"
+
+	table := createNumberTable()
+	table.SetNumberFormat(NumberFormat{Precision: 2, ThousandsSeparator: ",", Accounting: true})
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableColumnNumberFormat(t *testing.T) {
+	expected := "" +
+		"| Item   | 2024      |\n" +
+		"| ------ | --------- |\n" +
+		"| rent   | -1.250,50 |\n" +
+		"| salary |  4.200    |\n" +
+		"| share  |      0,13 |\n"
+
+	table := createNumberTable()
+	table.SetModeMarkdown()
+	table.SetNumberFormat(NumberFormat{Precision: 2, ThousandsSeparator: ","})
+	table.SetColumnNumberFormat(2, NumberFormat{Precision: 2, ThousandsSeparator: ".", DecimalMark: ','})
+	table.SetAlign(AlignDecimal, 2)
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableNumberFormatHTML(t *testing.T) {
+	expected := "<table class=\"termtable\">\n" +
+		"<thead>\n" +
+		"<tr><th>Item</th><th>2024</th></tr>\n" +
+		"</thead>\n" +
+		"<tbody>\n" +
+		"<tr><td>rent</td><td>-125050%</td></tr>\n" +
+		"<tr><td>salary</td><td>420000%</td></tr>\n" +
+		"<tr><td>share</td><td>12.6%</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+
+	table := createNumberTable()
+	table.SetModeHTML()
+	table.SetColumnNumberFormat(2, NumberFormat{Precision: -1, Percent: true})
+	checkRendersTo(t, table, expected)
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SetColumnPriority sets the priority of a column, counting from 1, for
+// terminal output too wide for MaxColumns: columns are left out, highest
+// priority number first and, amongst equals, rightmost first, until the
+// table fits, before it is split or drawn in expanded mode.  Columns with
+// priority 0, the default, are never left out.
+func (t *Table) SetColumnPriority(column, priority int) {
+	if column < 1 {
+		return
+	}
+	t.columnConfig(column - 1).priority = priority
+}
+
+// SetHiddenColumnsNote controls whether a note listing the columns left out
+// to fit MaxColumns, by their headers, is drawn below the table.
+func (t *Table) SetHiddenColumnsNote(onoff bool) {
+	t.hiddenColumnsNote = onoff
+}
+
+// dropColumns returns the table without the columns left out to fit
+// MaxColumns, by their priorities, and the note listing them, if wanted;
+// the table itself is returned if nothing is left out.
+func (t *Table) dropColumns() (*Table, string) {
+	candidates := []int{}
+	for i, cc := range t.columnConfigs {
+		if cc.priority > 0 {
+			candidates = append(candidates, i+1)
+		}
+	}
+	if len(candidates) == 0 || t.maxLineWidth(t.renderTerminal()) <= MaxColumns {
+		return t, ""
+	}
+	sort.Sort(byPriority{columns: candidates, table: t})
+
+	hidden := map[int]bool{}
+	tt := t
+	for _, column := range candidates {
+		hidden[column] = true
+		kept := []int{}
+		for i := 1; i <= t.columnCount(); i++ {
+			if !hidden[i] {
+				kept = append(kept, i)
+			}
+		}
+		tt = t.Select(kept...)
+		if tt.maxLineWidth(tt.renderTerminal()) <= MaxColumns {
+			break
+		}
+	}
+
+	if !t.hiddenColumnsNote {
+		return tt, ""
+	}
+	headers := createHeaderRow(t.headers)
+	names := []string{}
+	for i := 1; i <= t.columnCount(); i++ {
+		switch {
+		case !hidden[i]:
+		case i <= len(headers.cells):
+			names = append(names, headers.cells[i-1].formattedValue)
+		default:
+			names = append(names, "column "+strconv.Itoa(i))
+		}
+	}
+	return tt, "Hidden columns: " + strings.Join(names, ", ") + "\n"
+}
+
+// byPriority sorts columns, counting from 1, in the order they are dropped:
+// highest priority first, then from the right.
+type byPriority struct {
+	columns []int
+	table   *Table
+}
+
+func (s byPriority) Len() int      { return len(s.columns) }
+func (s byPriority) Swap(i, j int) { s.columns[i], s.columns[j] = s.columns[j], s.columns[i] }
+func (s byPriority) Less(i, j int) bool {
+	pi := s.table.columnConfigs[s.columns[i]-1].priority
+	pj := s.table.columnConfigs[s.columns[j]-1].priority
+	if pi != pj {
+		return pi > pj
+	}
+	return s.columns[i] > s.columns[j]
+}
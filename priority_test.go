@@ -0,0 +1,50 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func TestTableColumnPriority(t *testing.T) {
+	defer func(columns int) { MaxColumns = columns }(MaxColumns)
+
+	table := createMatrixTable()
+	table.SetColumnPriority(2, 1)
+	table.SetColumnPriority(3, 2)
+	table.SetColumnPriority(5, 1)
+
+	MaxColumns = 80
+	checkRendersTo(t, table, table.renderTerminal())
+
+	expected := "" +
+		"+--------------------------------+\n" +
+		"|             Matrix             |\n" +
+		"+------+-------+-------+---------+\n" +
+		"| Name | Alpha | Gamma | Epsilon |\n" +
+		"+------+-------+-------+---------+\n" +
+		"| one  | 1     | 3     | 5       |\n" +
+		"| two  | 10    | 30    | 50      |\n" +
+		"+------+-------+-------+---------+\n"
+	MaxColumns = 40
+	checkRendersTo(t, table, expected)
+
+	expected = "" +
+		"+------------------------+\n" +
+		"|         Matrix         |\n" +
+		"+------+-------+---------+\n" +
+		"| Name | Gamma | Epsilon |\n" +
+		"+------+-------+---------+\n" +
+		"| one  | 3     | 5       |\n" +
+		"| two  | 30    | 50      |\n" +
+		"+------+-------+---------+\n" +
+		"Hidden columns: Alpha, Beta, Delta\n"
+	MaxColumns = 30
+	table.SetHiddenColumnsNote(true)
+	checkRendersTo(t, table, expected)
+
+	// Expanded mode only applies if the table is still too wide.
+	MaxColumns = 20
+	table.SetExpanded(ExpandedAuto)
+	checkRendersTo(t, table, table.Select(1, 4, 6).renderExpanded()+"Hidden columns: Alpha, Beta, Delta\n")
+}
@@ -7,7 +7,21 @@ import "strings"
 // A Row represents one row of a Table, consisting of some number of Cell
 // items.
 type Row struct {
-	cells []*Cell
+	cells     []*Cell
+	textStyle TextStyle
+
+	// header rows are not subject to per-column settings
+	header bool
+
+	// footer rows only apply per-column settings to computed aggregates
+	footer bool
+
+	// upperCase rows, of column headers, are drawn in upper case
+	upperCase bool
+
+	// elided is the number of rows left out, for the row drawn in their
+	// place when a row limit applies
+	elided int
 }
 
 // CreateRow returns a Row where the cells are created as needed to hold each
@@ -21,6 +35,14 @@ func CreateRow(items []interface{}) *Row {
 	return row
 }
 
+// createHeaderRow returns a Row for headers or titles, which per-column
+// settings do not apply to.
+func createHeaderRow(items []interface{}) *Row {
+	row := CreateRow(items)
+	row.header = true
+	return row
+}
+
 // AddCell adds one item to a row as a new cell, where the item is either a
 // Cell or content to be put into a cell.
 func (r *Row) AddCell(item interface{}) {
@@ -32,6 +54,71 @@ func (r *Row) AddCell(item interface{}) {
 	}
 }
 
+// Value returns the raw value of the cell in a column of the row, counting
+// from 1, or nil if there is none.  Columns are found by counting the span of
+// each cell along the row, and a cell spanning several columns has its value
+// in the first of them only.
+func (r *Row) Value(column int) interface{} {
+	return r.value(column - 1)
+}
+
+// value returns the raw value of the cell in a column of the row, counting
+// from 0, or nil if there is none.
+func (r *Row) value(column int) interface{} {
+	if c := r.cellAt(column); c != nil {
+		return c.value
+	}
+	return nil
+}
+
+// cellAt returns the cell which starts at a column of the row, counting from
+// 0 and by the span of each cell, or nil if there is none.
+func (r *Row) cellAt(column int) *Cell {
+	start := 0
+	for _, c := range r.cells {
+		if start == column {
+			return c
+		}
+		if start > column {
+			break
+		}
+		start += c.colSpan
+	}
+	return nil
+}
+
+// columnOf returns the column, counting from 0 and by the span of each cell,
+// at which a cell of the row starts.
+func (r *Row) columnOf(cell *Cell) int {
+	start := 0
+	for _, c := range r.cells {
+		if c == cell {
+			break
+		}
+		start += c.colSpan
+	}
+	return start
+}
+
+// isData reports whether the row is one of the data rows of a table, rather
+// than a header, footer, subtotal or other row added to lay it out.
+func (r *Row) isData() bool {
+	return !r.header && !r.footer
+}
+
+// columnSettings reports whether per-column settings apply to a cell in the
+// row: they do in data rows, but not in headers or titles, nor to the items
+// in a footer other than the aggregates computed from the column.
+func (r *Row) columnSettings(c *Cell) bool {
+	return !r.header && (!r.footer || c.computed)
+}
+
+// SetStyle sets the TextStyle for the content of every cell in the row; any
+// style given to an individual cell is layered on top of this.
+func (r *Row) SetStyle(style TextStyle) {
+	r.textStyle = style
+}
+
 // Render returns a string representing the content of one row of a table, where
 // the Row contains Cells (not Separators) and the representation includes any
 // vertical borders needed.
@@ -39,9 +126,15 @@ func (r *Row) Render(style *renderStyle) string {
 	// pre-render and shove into an array... helps with cleanly adding borders
 	renderedCells := []string{}
 	for _, c := range r.cells {
-		renderedCells = append(renderedCells, c.Render(style))
+		renderedCells = append(renderedCells, c.render(style, r))
 	}
 
 	// format final output
-	return style.BorderY + strings.Join(renderedCells, style.BorderY) + style.BorderY
+	if style.SkipVerticalBorders {
+		line := strings.Join(renderedCells, style.border(style.Gutter))
+		line = strings.TrimPrefix(line, strings.Repeat(" ", style.PaddingLeft))
+		return trimTrailingSpace(line)
+	}
+	borderY := style.border(style.BorderY)
+	return borderY + strings.Join(renderedCells, borderY) + borderY
 }
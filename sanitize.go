@@ -0,0 +1,127 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type controlCharPolicy int
+
+// These constants control what happens to control characters in cell
+// content: the C0 controls, DEL and the C1 controls.
+const (
+	// ControlCharsKeep emits control characters unchanged; this is the
+	// default, but any control character can disturb the table layout.
+	ControlCharsKeep = controlCharPolicy(0)
+
+	// ControlCharsEscape replaces control characters with a visible escape,
+	// as in a Go string literal, such as \t or \x07.
+	ControlCharsEscape = controlCharPolicy(1)
+
+	// ControlCharsPicture replaces C0 control characters and DEL with the
+	// corresponding symbols of the Unicode Control Pictures block, such as
+	// U+2407 for BEL; C1 controls, which have no pictures, are escaped.
+	ControlCharsPicture = controlCharPolicy(2)
+)
+
+// A SanitizePolicy controls the handling of cell content which could break
+// the table layout or, where the content comes from untrusted sources, be
+// used to inject terminal escape sequences.  The zero value leaves content
+// unchanged.
+//
+// Sanitising applies to cell values only: colours, links and borders which
+// come from the table's own styling are added afterwards.
+type SanitizePolicy struct {
+	// TabStop, if positive, causes tabs to be expanded with spaces to the
+	// next multiple of TabStop character-cells from the start of the cell;
+	// otherwise tabs are handled as any other control character.
+	TabStop int
+
+	// ControlChars sets the handling of control characters.  Note that
+	// ESC is a control character, so escaping or replacing it makes any
+	// escape sequence visible, including SGR colours embedded in content.
+	ControlChars controlCharPolicy
+
+	// StripEscapes removes all terminal escape sequences from content
+	// before any control characters are handled.
+	StripEscapes bool
+}
+
+// IsZero reports whether the policy leaves content unchanged.
+func (p SanitizePolicy) IsZero() bool {
+	return p == SanitizePolicy{}
+}
+
+// sanitize applies the policy to text, measuring widths for tab expansion
+// with the supplied style.
+func (p SanitizePolicy) sanitize(text string, style *renderStyle) string {
+	if p.StripEscapes {
+		text = stripEscapes(text)
+	}
+	if p.TabStop > 0 && strings.ContainsRune(text, '\t') {
+		text = p.expandTabs(text, style)
+	}
+	if p.ControlChars != ControlCharsKeep {
+		var b strings.Builder
+		for _, r := range text {
+			if unicode.IsControl(r) {
+				b.WriteString(p.controlReplacement(r))
+			} else {
+				b.WriteRune(r)
+			}
+		}
+		text = b.String()
+	}
+	return text
+}
+
+// expandTabs replaces each tab with enough spaces to reach the next tab stop.
+func (p SanitizePolicy) expandTabs(text string, style *renderStyle) string {
+	var b strings.Builder
+	column := 0
+	for i, part := range strings.Split(text, "\t") {
+		if i > 0 {
+			n := p.TabStop - column%p.TabStop
+			b.WriteString(strings.Repeat(" ", n))
+			column += n
+		}
+		b.WriteString(part)
+		column += style.textWidth(part)
+	}
+	return b.String()
+}
+
+// controlReplacement returns what a control character is to be shown as.
+func (p SanitizePolicy) controlReplacement(r rune) string {
+	if p.ControlChars == ControlCharsPicture {
+		switch {
+		case r < 0x20:
+			return string(0x2400 + r)
+		case r == 0x7f:
+			return "\u2421"
+		}
+	}
+	switch r {
+	case '\a':
+		return `\a`
+	case '\b':
+		return `\b`
+	case '\f':
+		return `\f`
+	case '\n':
+		return `\n`
+	case '\r':
+		return `\r`
+	case '\t':
+		return `\t`
+	case '\v':
+		return `\v`
+	}
+	if r < 0x80 {
+		return fmt.Sprintf(`\x%02x`, r)
+	}
+	return fmt.Sprintf(`\u%04x`, r)
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+
+	"github.com/apcera/termtables/term"
+)
+
+func TestSanitize(t *testing.T) {
+	style := &renderStyle{}
+	tests := []struct {
+		policy SanitizePolicy
+		in     string
+		out    string
+	}{
+		{SanitizePolicy{}, "a\tb\x07", "a\tb\x07"},
+		{SanitizePolicy{TabStop: 4}, "a\tbcde\tf", "a   bcde    f"},
+		{SanitizePolicy{TabStop: 4}, "\033[1mab\033[0m\tc", "\033[1mab\033[0m  c"},
+		{SanitizePolicy{TabStop: 8}, "ｗｉｄｅ\tx", "ｗｉｄｅ        x"},
+		{SanitizePolicy{ControlChars: ControlCharsEscape}, "a\tb\x07c\r\x00\x7f\u0085", `a\tb\ac\r\x00\x7f\u0085`},
+		{SanitizePolicy{ControlChars: ControlCharsPicture}, "a\tb\x07c\r\x00\x7f\u0085", "a\u2409b\u2407c\u240d\u2400\u2421\\u0085"},
+		{SanitizePolicy{ControlChars: ControlCharsEscape}, "\033[31mred\033[0m", `\x1b[31mred\x1b[0m`},
+		{SanitizePolicy{StripEscapes: true}, "\033]0;pwned\007\033[31mred\033[0m", "red"},
+		{SanitizePolicy{StripEscapes: true, ControlChars: ControlCharsEscape}, "\033[2Kred\b", `red\b`},
+	}
+	for _, test := range tests {
+		if got := test.policy.sanitize(test.in, style); got != test.out {
+			t.Errorf("Unexpected result of %+v for %q; expected %q but got %q", test.policy, test.in, test.out, got)
+		}
+	}
+}
+
+func TestTableSanitizePolicy(t *testing.T) {
+	expected := "" +
+		"+-----------------+--------------+\n" +
+		"| User            | Note         |\n" +
+		"+-----------------+--------------+\n" +
+		"| alice           | \033[32mok\033[0m           |\n" +
+		"| \\x1b]0;x\\a\\rbob | \033[32mtab     stop\033[0m |\n" +
+		"+-----------------+--------------+\n"
+
+	table := CreateTable()
+	table.SetColorDepth(term.TrueColor)
+	table.SetSanitizePolicy(SanitizePolicy{TabStop: 8, ControlChars: ControlCharsEscape})
+	table.SetColumnStyle(2, TextStyle{Foreground: ColorGreen})
+	table.AddHeaders("User", "Note")
+	table.AddRow("alice", "ok")
+	table.AddRow("\033]0;x\a\rbob", "tab\tstop")
+
+	checkRendersTo(t, table, expected)
+}
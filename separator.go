@@ -43,9 +43,25 @@ func (s *Separator) Render(style *renderStyle) string {
 	parts := []string{}
 	for i := 0; i < style.columns; i++ {
 		w := style.PaddingLeft + style.CellWidth(i) + style.PaddingRight
-		parts = append(parts, strings.Repeat(style.BorderX, w))
+		if style.SkipVerticalBorders {
+			// like the rows, without the padding at either end
+			if i == 0 {
+				w -= style.PaddingLeft
+			}
+			if i == style.columns-1 {
+				w -= style.PaddingRight
+			}
+		}
+		parts = append(parts, style.rule(w))
 	}
 
+	if style.SkipVerticalBorders {
+		return style.border(strings.Join(parts, style.rule(style.textWidth(style.Gutter))))
+	}
+	return style.border(s.line(style, parts))
+}
+
+func (s *Separator) line(style *renderStyle, parts []string) string {
 	switch s.where {
 	case LINE_TOP:
 		return style.BorderTopLeft + strings.Join(parts, style.BorderTop) + style.BorderTopRight
@@ -0,0 +1,243 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type sortOrder int
+
+// These constants give the order in which to sort rows by a column.
+const (
+	SortAscending sortOrder = iota
+	SortDescending
+)
+
+// A SortKey gives one column to sort the rows of a table by, counting from
+// 1, and how to compare its values.  The raw cell values are compared:
+// numbers numerically, times chronologically, booleans with false first and
+// strings by their runes, or, with Natural, with runs of digits compared by
+// their numeric value ("file2" before "file10"); IgnoreCase compares strings
+// case-insensitively.  Values of other or differing types are compared by
+// their text, and missing or nil values sort before all others.  If Compare
+// is set, it is used instead, returning a negative number, zero or a
+// positive number as a sorts before, with or after b.
+type SortKey struct {
+	Column     int
+	Order      sortOrder
+	Natural    bool
+	IgnoreCase bool
+	Compare    func(a, b interface{}) int
+}
+
+// SortOptions describes how to sort the rows of a table, by each of the Keys
+// in turn.  Separators, and rows such as group headers and subtotals, split
+// the rows into groups which are sorted separately, unless DropSeparators is
+// set, in which case the separators are removed and all the rows sorted
+// together.
+type SortOptions struct {
+	Keys           []SortKey
+	DropSeparators bool
+}
+
+// SortBy sorts the rows of the table by the values in one column, counting
+// from 1, within each group of rows between separators.  Rows with equal
+// values keep their order, so sorting by several columns in turn, from the
+// least significant, sorts by all of them; Sort does that in one go.
+func (t *Table) SortBy(column int, order sortOrder) {
+	t.Sort(SortOptions{Keys: []SortKey{{Column: column, Order: order}}})
+}
+
+// Sort sorts the rows of the table as described by the options.  The sort is
+// stable, so rows which compare equal by every key keep their order.
+func (t *Table) Sort(options SortOptions) {
+	less := func(a, b *Row) bool {
+		for _, key := range options.Keys {
+			if key.Column < 1 {
+				continue
+			}
+			c := key.compare(a.value(key.Column-1), b.value(key.Column-1))
+			if key.Order == SortDescending {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	}
+
+	elements := make([]Element, 0, len(t.elements))
+	var group []*Row
+	flush := func() {
+		sort.Stable(rowSorter{rows: group, less: less})
+		for _, row := range group {
+			elements = append(elements, row)
+		}
+		group = group[:0]
+	}
+	for _, e := range t.elements {
+		if row, ok := e.(*Row); ok && row.isData() {
+			group = append(group, row)
+			continue
+		}
+		if options.DropSeparators {
+			continue
+		}
+		flush()
+		elements = append(elements, e)
+	}
+	flush()
+	t.elements = elements
+}
+
+// rowSorter sorts rows by a less function, for sort.Stable.
+type rowSorter struct {
+	rows []*Row
+	less func(a, b *Row) bool
+}
+
+func (s rowSorter) Len() int           { return len(s.rows) }
+func (s rowSorter) Swap(i, j int)      { s.rows[i], s.rows[j] = s.rows[j], s.rows[i] }
+func (s rowSorter) Less(i, j int) bool { return s.less(s.rows[i], s.rows[j]) }
+
+// compare returns the ordering of two cell values for this key.
+func (key SortKey) compare(a, b interface{}) int {
+	if key.Compare != nil {
+		return key.Compare(a, b)
+	}
+
+	av, bv := sortValue(a), sortValue(b)
+	switch {
+	case !av.IsValid() && !bv.IsValid():
+		return 0
+	case !av.IsValid():
+		return -1
+	case !bv.IsValid():
+		return 1
+	}
+
+	if at, ok := av.Interface().(time.Time); ok {
+		if bt, ok := bv.Interface().(time.Time); ok {
+			switch {
+			case at.Before(bt):
+				return -1
+			case at.After(bt):
+				return 1
+			}
+			return 0
+		}
+	}
+
+	ak, bk := numberKind(av.Kind()), numberKind(bv.Kind())
+	switch {
+	case ak == reflect.Int && bk == reflect.Int:
+		return compareOrdered(av.Int() < bv.Int(), av.Int() > bv.Int())
+	case ak == reflect.Uint && bk == reflect.Uint:
+		return compareOrdered(av.Uint() < bv.Uint(), av.Uint() > bv.Uint())
+	case ak != reflect.Invalid && bk != reflect.Invalid:
+		af, bf := numberFloat(av), numberFloat(bv)
+		return compareOrdered(af < bf, af > bf)
+	case av.Kind() == reflect.Bool && bv.Kind() == reflect.Bool:
+		return compareOrdered(!av.Bool() && bv.Bool(), av.Bool() && !bv.Bool())
+	}
+
+	as, bs := renderValue(av.Interface()), renderValue(bv.Interface())
+	if av.Kind() == reflect.String && bv.Kind() == reflect.String {
+		as, bs = av.String(), bv.String()
+	}
+	if key.IgnoreCase {
+		as, bs = strings.ToLower(as), strings.ToLower(bs)
+	}
+	if key.Natural {
+		return compareNatural(as, bs)
+	}
+	return strings.Compare(as, bs)
+}
+
+// sortValue returns v with any pointers followed; it is invalid for nil.
+func sortValue(v interface{}) reflect.Value {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return reflect.Value{}
+		}
+		rv = rv.Elem()
+	}
+	return rv
+}
+
+// numberKind returns reflect.Int, reflect.Uint or reflect.Float64 for the
+// kinds of signed integer, unsigned integer and floating-point values, and
+// reflect.Invalid for any other kind.
+func numberKind(k reflect.Kind) reflect.Kind {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.Int
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return reflect.Uint
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	}
+	return reflect.Invalid
+}
+
+func numberFloat(v reflect.Value) float64 {
+	switch numberKind(v.Kind()) {
+	case reflect.Int:
+		return float64(v.Int())
+	case reflect.Uint:
+		return float64(v.Uint())
+	}
+	return v.Float()
+}
+
+func compareOrdered(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	}
+	return 0
+}
+
+// compareNatural compares two strings with each run of digits taken as one
+// number, so that "file2" sorts before "file10".
+func compareNatural(a, b string) int {
+	for a != "" && b != "" {
+		ar, asize := utf8.DecodeRuneInString(a)
+		br, bsize := utf8.DecodeRuneInString(b)
+		if isDigit(ar) && isDigit(br) {
+			an, bn := digitRun(a), digitRun(b)
+			at, bt := strings.TrimLeft(a[:an], "0"), strings.TrimLeft(b[:bn], "0")
+			if c := compareOrdered(len(at) < len(bt), len(at) > len(bt)); c != 0 {
+				return c
+			}
+			if c := strings.Compare(at, bt); c != 0 {
+				return c
+			}
+			a, b = a[an:], b[bn:]
+			continue
+		}
+		if ar != br {
+			return compareOrdered(ar < br, ar > br)
+		}
+		a, b = a[asize:], b[bsize:]
+	}
+	return compareOrdered(len(a) < len(b), len(a) > len(b))
+}
+
+// digitRun returns the length of the run of ASCII digits at the start of s.
+func digitRun(s string) int {
+	i := 0
+	for i < len(s) && isDigit(rune(s[i])) {
+		i++
+	}
+	return i
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSortKeyCompare(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	n := 3
+	tests := []struct {
+		key  SortKey
+		a, b interface{}
+		want int
+	}{
+		{SortKey{}, 2, 10, -1},
+		{SortKey{}, 2.5, 2, 1},
+		{SortKey{}, int8(-1), uint64(1), -1},
+		{SortKey{}, uint64(1 << 63), uint64(1<<63 + 1), -1},
+		{SortKey{}, 2 * time.Second, time.Minute, -1},
+		{SortKey{}, t0, t0.Add(-time.Hour), 1},
+		{SortKey{}, t0, t0, 0},
+		{SortKey{}, false, true, -1},
+		{SortKey{}, nil, 0, -1},
+		{SortKey{}, &n, 2, 1},
+		{SortKey{}, "b", "a", 1},
+		{SortKey{}, "B", "a", -1},
+		{SortKey{IgnoreCase: true}, "B", "a", 1},
+		{SortKey{IgnoreCase: true}, "ABC", "abc", 0},
+		{SortKey{}, "file10", "file2", -1},
+		{SortKey{Natural: true}, "file10", "file2", 1},
+		{SortKey{Natural: true}, "file02", "file2", 0},
+		{SortKey{Natural: true}, "v1.10.0", "v1.9.3", 1},
+		{SortKey{Natural: true}, "a", "a1", -1},
+		{SortKey{}, "10", 9, -1},
+		{SortKey{Compare: func(a, b interface{}) int { return len(a.(string)) - len(b.(string)) }}, "aaa", "b", 2},
+	}
+	for _, test := range tests {
+		if got := test.key.compare(test.a, test.b); got != test.want {
+			t.Errorf("Unexpected comparison of %#v with %#v using %+v; expected %d but got %d", test.a, test.b, test.key, test.want, got)
+		}
+	}
+}
+
+func createSortTable() *Table {
+	table := CreateTable()
+	table.AddHeaders("Host", "Zone", "Load")
+	table.AddRow("web10", "b", 0.5)
+	table.AddRow("web2", "a", 1.25)
+	table.AddRow("db1", "b", 0.5)
+	table.AddSeparator()
+	table.AddRow("cache3", "a", 3)
+	table.AddRow("cache1", "a", 0.75)
+	return table
+}
+
+func TestTableSortBy(t *testing.T) {
+	expected := "" +
+		"+--------+------+------+\n" +
+		"| Host   | Zone | Load |\n" +
+		"+--------+------+------+\n" +
+		"| web2   | a    | 1.25 |\n" +
+		"| web10  | b    | 0.50 |\n" +
+		"| db1    | b    | 0.50 |\n" +
+		"+--------+------+------+\n" +
+		"| cache3 | a    | 3    |\n" +
+		"| cache1 | a    | 0.75 |\n" +
+		"+--------+------+------+\n"
+
+	table := createSortTable()
+	table.SortBy(3, SortDescending)
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableSortMultipleKeys(t *testing.T) {
+	expected := "" +
+		"+--------+------+------+\n" +
+		"| Host   | Zone | Load |\n" +
+		"+--------+------+------+\n" +
+		"| cache1 | a    | 0.75 |\n" +
+		"| cache3 | a    | 3    |\n" +
+		"| web2   | a    | 1.25 |\n" +
+		"| db1    | b    | 0.50 |\n" +
+		"| web10  | b    | 0.50 |\n" +
+		"+--------+------+------+\n"
+
+	table := createSortTable()
+	table.Sort(SortOptions{
+		Keys: []SortKey{
+			{Column: 2},
+			{Column: 3, Compare: func(a, b interface{}) int { return 0 }},
+			{Column: 1, Natural: true},
+		},
+		DropSeparators: true,
+	})
+	checkRendersTo(t, table, expected)
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"strings"
+)
+
+// SetSplitColumns controls whether terminal output too wide for MaxColumns
+// is split into several tables, one below another, each holding as many of
+// the columns as fit, in order.  The key columns, counting from 1, are
+// repeated on the left of every one of them, to identify the rows.  Each
+// table has its own borders, headers and footer, and only the first has the
+// title.  Keys given more than once or below 1 are ignored, as are keys past
+// the last column when the table is drawn.  Splitting takes precedence over
+// ExpandedAuto.
+func (t *Table) SetSplitColumns(onoff bool, keys ...int) {
+	if !onoff {
+		t.splitKeys = nil
+		return
+	}
+	t.splitKeys = []int{}
+	seen := map[int]bool{}
+	for _, key := range keys {
+		if key >= 1 && !seen[key] {
+			seen[key] = true
+			t.splitKeys = append(t.splitKeys, key)
+		}
+	}
+}
+
+// renderSplit returns the table drawn as several tables, one below another,
+// each within MaxColumns if possible; a chunk always has at least one
+// column besides the keys, however wide.  If every column is a key, the
+// table is drawn whole.
+func (t *Table) renderSplit() string {
+	columns := t.columnCount()
+	keys := []int{}
+	isKey := map[int]bool{}
+	for _, key := range t.splitKeys {
+		if key <= columns {
+			keys = append(keys, key)
+			isKey[key] = true
+		}
+	}
+	rest := []int{}
+	for column := 1; column <= columns; column++ {
+		if !isKey[column] {
+			rest = append(rest, column)
+		}
+	}
+	if len(rest) == 0 {
+		return t.renderTerminal()
+	}
+
+	chunks := []string{}
+	for len(rest) > 0 {
+		var out string
+		n := 0
+		for n < len(rest) {
+			chunk := t.splitChunk(append(append([]int{}, keys...), rest[:n+1]...), len(chunks) == 0)
+			if n > 0 && t.maxLineWidth(chunk) > MaxColumns {
+				break
+			}
+			out = chunk
+			n++
+		}
+		chunks = append(chunks, out)
+		rest = rest[n:]
+	}
+	return strings.Join(chunks, "\n")
+}
+
+// splitChunk returns the given columns of the table drawn as one table, with
+// the title only if first is set.
+func (t *Table) splitChunk(columns []int, first bool) string {
+	tt := t.Select(columns...)
+	if !first {
+		tt.title = nil
+	}
+	return tt.renderTerminal()
+}
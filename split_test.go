@@ -0,0 +1,132 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func createMatrixTable() *Table {
+	table := CreateTable()
+	table.AddTitle("Matrix")
+	table.AddHeaders("Name", "Alpha", "Beta", "Gamma", "Delta", "Epsilon")
+	table.AddRow("one", 1, 2, 3, 4, 5)
+	table.AddRow("two", 10, 20, 30, 40, 50)
+	return table
+}
+
+func TestTableSplitColumns(t *testing.T) {
+	defer func(columns int) { MaxColumns = columns }(MaxColumns)
+	MaxColumns = 30
+
+	expected := "" +
+		"+---------------------+\n" +
+		"|       Matrix        |\n" +
+		"+------+-------+------+\n" +
+		"| Name | Alpha | Beta |\n" +
+		"+------+-------+------+\n" +
+		"| one  | 1     | 2    |\n" +
+		"| two  | 10    | 20   |\n" +
+		"+------+-------+------+\n" +
+		"| Sum  | 11    | 22   |\n" +
+		"+------+-------+------+\n" +
+		"\n" +
+		"+------+-------+-------+\n" +
+		"| Name | Gamma | Delta |\n" +
+		"+------+-------+-------+\n" +
+		"| one  | 3     | 4     |\n" +
+		"| two  | 30    | 40    |\n" +
+		"+------+-------+-------+\n" +
+		"| Sum  | 33    | 44    |\n" +
+		"+------+-------+-------+\n" +
+		"\n" +
+		"+------+---------+\n" +
+		"| Name | Epsilon |\n" +
+		"+------+---------+\n" +
+		"| one  | 5       |\n" +
+		"| two  | 50      |\n" +
+		"+------+---------+\n" +
+		"| Sum  | 55      |\n" +
+		"+------+---------+\n"
+
+	table := createMatrixTable()
+	table.AddFooter("Sum", AggregateSum, AggregateSum, AggregateSum, AggregateSum, AggregateSum)
+	table.SetSplitColumns(true, 1)
+	checkRendersTo(t, table, expected)
+
+	// Tables which fit are drawn whole.
+	MaxColumns = 80
+	checkRendersTo(t, table, table.renderTerminal())
+}
+
+func TestTableSplitColumnsWithoutKeys(t *testing.T) {
+	defer func(columns int) { MaxColumns = columns }(MaxColumns)
+	MaxColumns = 20
+
+	expected := "" +
+		"+--------------+\n" +
+		"|    Matrix    |\n" +
+		"+------+-------+\n" +
+		"| Name | Alpha |\n" +
+		"+------+-------+\n" +
+		"| one  | 1     |\n" +
+		"| two  | 10    |\n" +
+		"+------+-------+\n" +
+		"\n" +
+		"+------+-------+\n" +
+		"| Beta | Gamma |\n" +
+		"+------+-------+\n" +
+		"| 2    | 3     |\n" +
+		"| 20   | 30    |\n" +
+		"+------+-------+\n" +
+		"\n" +
+		"+-------+---------+\n" +
+		"| Delta | Epsilon |\n" +
+		"+-------+---------+\n" +
+		"| 4     | 5       |\n" +
+		"| 40    | 50      |\n" +
+		"+-------+---------+\n"
+
+	table := createMatrixTable()
+	table.SetSplitColumns(true)
+	table.SetExpanded(ExpandedAuto)
+	checkRendersTo(t, table, expected)
+
+	table.SetSplitColumns(false)
+	checkRendersTo(t, table, table.renderExpanded())
+}
+
+func TestTableSplitColumnsAllKeys(t *testing.T) {
+	defer func(columns int) { MaxColumns = columns }(MaxColumns)
+	MaxColumns = 10
+
+	table := CreateTable()
+	table.AddHeaders("Name", "Value")
+	table.AddRow("alpha", 1)
+	table.SetSplitColumns(true, 1, 2, 2)
+	checkRendersTo(t, table, table.renderTerminal())
+}
+
+func TestTableSplitColumnsKeyPastLastColumn(t *testing.T) {
+	defer func(columns int) { MaxColumns = columns }(MaxColumns)
+	MaxColumns = 20
+
+	expected := "" +
+		"+------+-------+\n" +
+		"| Name | Alpha |\n" +
+		"+------+-------+\n" +
+		"| one  | 1     |\n" +
+		"+------+-------+\n" +
+		"\n" +
+		"+------+------+\n" +
+		"| Name | Beta |\n" +
+		"+------+------+\n" +
+		"| one  | 2    |\n" +
+		"+------+------+\n"
+
+	table := CreateTable()
+	table.AddHeaders("Name", "Alpha", "Beta")
+	table.AddRow("one", 1, 2)
+	table.SetSplitColumns(true, 0, 1, 7)
+	checkRendersTo(t, table, expected)
+}
@@ -2,11 +2,6 @@
 
 package termtables
 
-import (
-	"strings"
-	"unicode/utf8"
-)
-
 // A StraightSeparator is a horizontal line with associated information about
 // what sort of position it takes in the table, so as to control which shapes
 // will be used where vertical lines are expected to touch this horizontal
@@ -18,19 +13,25 @@ type StraightSeparator struct {
 // Render returns a string representing this separator, with all border
 // crossings appropriately chosen.
 func (s *StraightSeparator) Render(style *renderStyle) string {
+	if style.SkipVerticalBorders {
+		return style.border(style.rule(style.Width))
+	}
+
 	// loop over getting dashes
 	width := 0
+	internalBorderWidth := style.textWidth(style.BorderI)
 	for i := 0; i < style.columns; i++ {
-		width += style.PaddingLeft + style.CellWidth(i) + style.PaddingRight + utf8.RuneCountInString(style.BorderI)
+		width += style.PaddingLeft + style.CellWidth(i) + style.PaddingRight + internalBorderWidth
 	}
 
+	rule := style.rule(width - internalBorderWidth)
 	switch s.where {
 	case LINE_TOP:
-		return style.BorderTopLeft + strings.Repeat(style.BorderX, width-1) + style.BorderTopRight
+		return style.border(style.BorderTopLeft + rule + style.BorderTopRight)
 	case LINE_INNER, LINE_SUBTOP:
-		return style.BorderLeft + strings.Repeat(style.BorderX, width-1) + style.BorderRight
+		return style.border(style.BorderLeft + rule + style.BorderRight)
 	case LINE_BOTTOM:
-		return style.BorderBottomLeft + strings.Repeat(style.BorderX, width-1) + style.BorderBottomRight
+		return style.border(style.BorderBottomLeft + rule + style.BorderBottomRight)
 	}
 	panic("not reached")
 }
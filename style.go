@@ -5,7 +5,9 @@ package termtables
 import (
 	"fmt"
 	"strings"
-	"unicode/utf8"
+
+	"github.com/apcera/termtables/term"
+	runewidth "github.com/mattn/go-runewidth"
 )
 
 type tableAlignment int
@@ -16,12 +18,75 @@ const (
 	AlignLeft   = tableAlignment(1)
 	AlignCenter = tableAlignment(2)
 	AlignRight  = tableAlignment(3)
+
+	// AlignDecimal lines up the decimal separators, as set by the TableStyle
+	// DecimalMark, of the numbers in a column.  Integers and numbers with
+	// unit suffixes are lined up as if the separator followed the last digit
+	// of the integer part.  Header cells and cells with no number are not
+	// lined up, but drawn as a whole at the left.
+	AlignDecimal = tableAlignment(4)
 )
 
+type ambiguousWidth int
+
+// These constants control how many character-cells are taken by characters
+// whose width is "ambiguous" per Unicode Standard Annex #11, such as Greek
+// and Cyrillic letters and the UTF-8 box-drawing characters.  These are
+// drawn wide by terminals in East Asian locales and narrow elsewhere.
+const (
+	// AmbiguousAuto chooses according to the current locale, per the same
+	// environment variables as EnableUTF8PerLocale.
+	AmbiguousAuto = ambiguousWidth(0)
+
+	AmbiguousNarrow = ambiguousWidth(1)
+	AmbiguousWide   = ambiguousWidth(2)
+)
+
+var (
+	narrowCondition = &runewidth.Condition{EastAsianWidth: false}
+	wideCondition   = &runewidth.Condition{EastAsianWidth: true}
+)
+
+// condition returns the runewidth.Condition with which to measure text.
+func (a ambiguousWidth) condition() *runewidth.Condition {
+	switch a {
+	case AmbiguousNarrow:
+		return narrowCondition
+	case AmbiguousWide:
+		return wideCondition
+	}
+	if isEastAsianLocale(getLocale()) {
+		return wideCondition
+	}
+	return narrowCondition
+}
+
 // TableStyle controls styling information for a Table as a whole.
 //
 // For the Border rules, only X, Y and I are needed, and all have defaults.
 // The others will all default to the same as BorderI.
+//
+// BorderTextStyle and HeaderTextStyle control the colours and attributes of
+// the border characters and of the column headers respectively, in terminal
+// output; the zero values leave them unstyled.
+//
+// AmbiguousWidth controls the width of East Asian ambiguous characters,
+// including in the borders.  Where the border characters are wide, column
+// widths are rounded up so that horizontal rules can be drawn exactly.
+//
+// IsolateBidi wraps the content of each cell in Unicode directional isolates
+// in terminal output, and marks cells with dir="auto" in HTML, so that
+// right-to-left text such as Hebrew or Arabic can't reorder the borders.
+// RightAlignRTL makes cells whose text is mostly right-to-left default to
+// AlignRight rather than to the table Alignment.
+//
+// DecimalMark is the decimal separator for AlignDecimal, by default '.'.
+//
+// SkipVerticalBorders drops the borders at the left and right of the table
+// and draws Gutter between cells in place of BorderY, with trailing spaces
+// trimmed from each line; SkipHorizontalBorders drops all horizontal rules,
+// including separators.  UpperCaseHeaders draws the column headers in upper
+// case.  The borders are always drawn in Markdown, whatever these say.
 type TableStyle struct {
 	SkipBorder        bool
 	BorderX           string
@@ -39,7 +104,19 @@ type TableStyle struct {
 	PaddingRight      int
 	Width             int
 	Alignment         tableAlignment
-	htmlRules         htmlStyleRules
+	BorderTextStyle   TextStyle
+	HeaderTextStyle   TextStyle
+	AmbiguousWidth    ambiguousWidth
+	IsolateBidi       bool
+	RightAlignRTL     bool
+	DecimalMark       rune
+
+	SkipVerticalBorders   bool
+	SkipHorizontalBorders bool
+	Gutter                string
+	UpperCaseHeaders      bool
+
+	htmlRules htmlStyleRules
 }
 
 // A CellStyle controls all style applicable to one Cell.
@@ -49,6 +126,14 @@ type CellStyle struct {
 
 	// ColSpan indicates how many columns this Cell is expected to consume.
 	ColSpan int
+
+	// TextStyle holds the colours and attributes for the content; these are
+	// only drawn in terminal output, and are translated to CSS for HTML.
+	TextStyle
+
+	// Format, if set, turns the value into the text drawn for the cell, in
+	// place of the default formatting; the raw value is kept.
+	Format FormatFunc
 }
 
 // DefaultStyle is a TableStyle which can be used to get some simple
@@ -65,40 +150,58 @@ var DefaultStyle = &TableStyle{
 	// order of a var and an init value adds undesired subtlety.
 }
 
+// PlainStyle is a TableStyle for the borderless, column-aligned output used
+// by tools such as kubectl and docker: upper-case headers, no rules, and
+// columns separated by two spaces.
+var PlainStyle = &TableStyle{
+	BorderX: "-", BorderY: "|", BorderI: "+",
+	Width:     80,
+	Alignment: AlignLeft,
+
+	SkipVerticalBorders:   true,
+	SkipHorizontalBorders: true,
+	Gutter:                "  ",
+	UpperCaseHeaders:      true,
+}
+
 type renderStyle struct {
-	cellWidths map[int]int
-	columns    int
+	cellWidths    map[int]int
+	decimalWidths map[int]decimalWidth
+	columns       int
 
 	// used for markdown rendering
 	replaceContent func(string) string
 
+	outputMode     outputMode
+	colorDepth     term.ColorDepth
+	columnConfigs  map[int]*columnConfig
+	widthCondition *runewidth.Condition
+	sanitize       SanitizePolicy
+
+	tableNumberFormat *NumberFormat
+	formatters        *formatterRegistry
+
+	// rowIndexes counts the rows being drawn, other than headers, from 0,
+	// for ColumnFormatFuncs; rows are shared between tables, so this is
+	// kept here rather than in each Row.
+	rowIndexes map[*Row]int
+
+	// suppressed holds the cells left blank as they repeat the value of
+	// the data row above, in the column given to GroupBy.
+	suppressed map[*Cell]bool
+
 	TableStyle
 }
 
 // setUtfBoxStyle changes the border characters to be suitable for use when
 // the output stream can render UTF-8 characters.
 func (s *TableStyle) setUtfBoxStyle() {
-	s.BorderX = "─"
-	s.BorderY = "│"
-	s.BorderI = "┼"
-	s.BorderTop = "┬"
-	s.BorderBottom = "┴"
-	s.BorderLeft = "├"
-	s.BorderRight = "┤"
-	s.BorderTopLeft = "╭"
-	s.BorderTopRight = "╮"
-	s.BorderBottomLeft = "╰"
-	s.BorderBottomRight = "╯"
+	s.setBorders(roundedBorders)
 }
 
 // setAsciiBoxStyle changes the border characters back to their defaults
 func (s *TableStyle) setAsciiBoxStyle() {
-	s.BorderX = "-"
-	s.BorderY = "|"
-	s.BorderI = "+"
-	s.BorderTop, s.BorderBottom, s.BorderLeft, s.BorderRight = "", "", "", ""
-	s.BorderTopLeft, s.BorderTopRight, s.BorderBottomLeft, s.BorderBottomRight = "", "", "", ""
-	s.fillStyleRules()
+	s.setBorders(asciiBorders)
 }
 
 // fillStyleRules populates members of the TableStyle box-drawing specification
@@ -131,40 +234,102 @@ func (s *TableStyle) fillStyleRules() {
 }
 
 func createRenderStyle(table *Table) *renderStyle {
-	style := &renderStyle{TableStyle: *table.Style, cellWidths: map[int]int{}}
+	style := &renderStyle{
+		TableStyle:     *table.Style,
+		cellWidths:     map[int]int{},
+		decimalWidths:  map[int]decimalWidth{},
+		rowIndexes:     map[*Row]int{},
+		suppressed:     map[*Cell]bool{},
+		outputMode:     table.outputMode,
+		colorDepth:     table.colorDepth,
+		columnConfigs:  table.columnConfigs,
+		widthCondition: table.Style.AmbiguousWidth.condition(),
+		sanitize:       table.sanitize,
+
+		tableNumberFormat: table.numberFormat,
+		formatters:        table.formatters,
+	}
 	style.TableStyle.fillStyleRules()
 
 	if table.outputMode == outputMarkdown {
+		style.SkipVerticalBorders = false
+		style.SkipHorizontalBorders = false
 		style.buildReplaceContent(table.Style.BorderY)
 	}
 
 	// FIXME: handle actually defined width condition
 
 	// loop over the rows and cells to calculate widths
+	index := 0
+	var previous *Row
 	for _, element := range table.elements {
 		// skip separators
 		if _, ok := element.(*Separator); ok {
+			previous = nil
 			continue
 		}
 
 		// iterate over cells
 		if row, ok := element.(*Row); ok {
+			if !row.header {
+				style.rowIndexes[row] = index
+				index++
+			}
+			if column := table.suppressColumn - 1; column >= 0 && row.isData() {
+				if c := row.cellAt(column); c != nil && previous != nil &&
+					(SortKey{}).compare(previous.value(column), c.value) == 0 {
+					style.suppressed[c] = true
+				}
+			}
+			previous = nil
+			if row.isData() {
+				previous = row
+			}
 			for i, cell := range row.cells {
 				// FIXME: need to support sizing with colspan handling
 				if cell.colSpan > 1 {
 					continue
 				}
-				if style.cellWidths[i] < cell.Width() {
-					style.cellWidths[i] = cell.Width()
+				w := cell.width(style, row)
+				// only numbers in data cells are lined up; others are
+				// drawn whole
+				left, right, number := 0, 0, false
+				if !row.header && cell.effectiveAlignment(style) == AlignDecimal {
+					left, right, number = style.decimalSplit(cell.content(style, row), cell.column)
+				}
+				if number {
+					dw := style.decimalWidths[i]
+					if dw.left < left {
+						dw.left = left
+					}
+					if dw.right < right {
+						dw.right = right
+					}
+					style.decimalWidths[i] = dw
+					if w < dw.left+dw.right {
+						w = dw.left + dw.right
+					}
+				}
+				if style.cellWidths[i] < w {
+					style.cellWidths[i] = w
 				}
 			}
 		}
 	}
 	style.columns = len(style.cellWidths)
+	style.fitRules()
 
 	// calculate actual width
-	width := utf8.RuneCountInString(style.BorderLeft) // start at '1' for left border
-	internalBorderWidth := utf8.RuneCountInString(style.BorderI)
+	// without vertical borders, the padding at the left and right of the
+	// table is dropped too
+	leftBorder, internalBorder, rightBorder := style.BorderLeft, style.BorderI, style.BorderRight
+	outerPadding := 0
+	if style.SkipVerticalBorders {
+		leftBorder, internalBorder, rightBorder = "", style.Gutter, ""
+		outerPadding = style.PaddingLeft + style.PaddingRight
+	}
+	width := style.textWidth(leftBorder) - outerPadding // start at '1' for left border
+	internalBorderWidth := style.textWidth(internalBorder)
 
 	lastIndex := 0
 	for i, v := range style.cellWidths {
@@ -173,24 +338,42 @@ func createRenderStyle(table *Table) *renderStyle {
 			lastIndex = i
 		}
 	}
-	if internalBorderWidth != utf8.RuneCountInString(style.BorderRight) {
-		width += utf8.RuneCountInString(style.BorderRight) - internalBorderWidth
+	if internalBorderWidth != style.textWidth(rightBorder) {
+		width += style.textWidth(rightBorder) - internalBorderWidth
 	}
 
-	if table.titleCell != nil {
-		titleMinWidth := 0 +
-			table.titleCell.Width() +
-			utf8.RuneCountInString(style.BorderLeft) +
-			utf8.RuneCountInString(style.BorderRight) +
-			style.PaddingLeft +
-			style.PaddingRight
-
-		if width < titleMinWidth {
-			// minWidth must be set to include padding of the title, as required
-			style.cellWidths[lastIndex] += (titleMinWidth - width)
-			width = titleMinWidth
+	// cells spanning the whole table, such as the title, can widen it
+	minWidth := 0
+	for _, element := range table.elements {
+		row, ok := element.(*Row)
+		if !ok {
+			continue
+		}
+		for _, cell := range row.cells {
+			if cell.colSpan < fullWidthSpan {
+				continue
+			}
+			cellMinWidth := 0 +
+				cell.width(style, row) +
+				style.textWidth(leftBorder) +
+				style.textWidth(rightBorder) +
+				style.PaddingLeft +
+				style.PaddingRight -
+				outerPadding
+			if minWidth < cellMinWidth {
+				minWidth = cellMinWidth
+			}
 		}
 	}
+	if width < minWidth {
+		// minWidth must be set to include padding of the cell, as required
+		style.cellWidths[lastIndex] += (minWidth - width)
+		width = minWidth
+
+		fitted := style.cellWidths[lastIndex]
+		style.fitRules()
+		width += style.cellWidths[lastIndex] - fitted
+	}
 
 	// right border is covered in loop
 	style.Width = width
@@ -198,12 +381,121 @@ func createRenderStyle(table *Table) *renderStyle {
 	return style
 }
 
+// fitRules rounds up the cell widths, where the BorderX character is more
+// than one character-cell wide, so that a whole number of them fill the
+// width of each column.
+func (s *renderStyle) fitRules() {
+	bx := s.textWidth(s.BorderX)
+	if bx < 2 {
+		return
+	}
+	for i, w := range s.cellWidths {
+		if r := (s.PaddingLeft + w + s.PaddingRight) % bx; r != 0 {
+			s.cellWidths[i] += bx - r
+		}
+	}
+}
+
+// cellGap returns the border drawn between two cells of a row.
+func (s *renderStyle) cellGap() string {
+	if s.SkipVerticalBorders {
+		return s.Gutter
+	}
+	return s.BorderY
+}
+
+// rule returns a horizontal rule of BorderX characters of the given width.
+func (s *renderStyle) rule(width int) string {
+	bx := s.textWidth(s.BorderX)
+	if bx < 1 {
+		return ""
+	}
+	return strings.Repeat(s.BorderX, width/bx)
+}
+
+// textWidth returns the number of tty character-cells needed to draw text.
+func (s *renderStyle) textWidth(text string) int {
+	cond := s.widthCondition
+	if cond == nil {
+		cond = narrowCondition
+	}
+	return textWidth(cond, text)
+}
+
 // CellWidth returns the width of the cell at the supplied index, where the
 // width is the number of tty character-cells required to draw the glyphs.
 func (s *renderStyle) CellWidth(i int) int {
 	return s.cellWidths[i]
 }
 
+// columnConfig returns the column settings which apply to a cell in a row;
+// these only apply to the data rows of a table, not to its headers, so this
+// is nil for those.
+func (s *renderStyle) columnConfig(r *Row, c *Cell) *columnConfig {
+	if r == nil || !r.columnSettings(c) {
+		return nil
+	}
+	return s.columnConfigs[r.columnOf(c)]
+}
+
+// textStyle returns the TextStyle with which to draw a cell in a row: the
+// column style, overlaid with any style from the column's format function,
+// the row style and then the cell's own style.
+func (s *renderStyle) textStyle(r *Row, c *Cell) TextStyle {
+	var ts TextStyle
+	if cc := s.columnConfig(r, c); cc != nil {
+		ts = cc.textStyle
+		if cc.format != nil && c.format == nil {
+			_, fs := cc.format(c.value, s.rowIndexes[r], r.columnOf(c)+1)
+			ts = ts.merge(fs)
+		}
+	}
+	if r != nil {
+		ts = ts.merge(r.textStyle)
+	}
+	return ts.merge(c.textStyle)
+}
+
+// applyTextStyle draws text in the given style, as far as the output mode
+// and colour depth can show it.
+func (s *renderStyle) applyTextStyle(text string, ts TextStyle) string {
+	if s.stripsColor() {
+		return text
+	}
+	return ts.downgrade(s.colorDepth).apply(text)
+}
+
+// applyLink makes text link to url, in the form suitable for the output mode;
+// terminals which can't show colour are assumed not to handle OSC 8 either.
+// HTML links are made in Row.HTML, after the content has been escaped.
+func (s *renderStyle) applyLink(text, url string) string {
+	switch s.outputMode {
+	case outputTerminal:
+		if s.colorDepth == term.NoColor {
+			return text
+		}
+		return "\033]8;;" + escapeLinkURL(url) + "\033\\" + text + "\033]8;;\033\\"
+	case outputMarkdown:
+		if !safeLinkURL(url) {
+			return text
+		}
+		return markdownLink(text, url)
+	}
+	return text
+}
+
+// stripsColor reports whether colour is unwanted in the output, either
+// because it is not for a terminal or because the terminal can't show it;
+// any SGR sequences embedded in cell content should then be removed.
+func (s *renderStyle) stripsColor() bool {
+	return s.outputMode != outputTerminal || s.colorDepth == term.NoColor
+}
+
+// border draws border characters in the BorderTextStyle.
+func (s *renderStyle) border(text string) string {
+	return s.applyTextStyle(text, s.BorderTextStyle)
+}
+
 // buildReplaceContent creates a function closure, with minimal bound lexical
 // state, which replaces content
 func (s *renderStyle) buildReplaceContent(bad string) {
@@ -5,6 +5,7 @@ package termtables
 import (
 	"bytes"
 	"os"
+	"reflect"
 	"runtime"
 	"strings"
 
@@ -40,6 +41,7 @@ var outputsEnabled struct {
 	HTML       bool
 	Markdown   bool
 	titleStyle titleStyle
+	colorDepth term.ColorDepth
 }
 
 var defaultOutputMode outputMode = outputTerminal
@@ -51,9 +53,64 @@ type Table struct {
 
 	elements   []Element
 	headers    []interface{}
+	footers    []interface{}
 	title      interface{}
-	titleCell  *Cell
 	outputMode outputMode
+	colorDepth term.ColorDepth
+	sanitize   SanitizePolicy
+
+	// numberFormat, if set, is used for numbers in every column without a
+	// format of its own.
+	numberFormat *NumberFormat
+
+	// formatters holds the Formatters registered for this table alone.
+	formatters *formatterRegistry
+
+	// rowLimit gives the number of data rows to draw at the start and end
+	// of the table, if either is non-zero.
+	rowLimit struct {
+		head, tail int
+	}
+	expanded expandedMode
+
+	// splitKeys, if not nil, has terminal output too wide for MaxColumns
+	// split into chunks of columns, each starting with these columns.
+	splitKeys []int
+
+	// suppressColumn is the column, counting from 1, in which GroupBy
+	// leaves values blank where they repeat the row above, or 0.
+	suppressColumn int
+
+	// hiddenColumnsNote has a note drawn below the table listing the
+	// columns left out by their priorities to fit MaxColumns.
+	hiddenColumnsNote bool
+
+	// columnConfigs holds per-column settings, keyed by the column index
+	// counting from 0, which apply at render time.
+	columnConfigs map[int]*columnConfig
+}
+
+// columnConfig holds settings for one column of a Table which apply to every
+// data cell in that column, whenever the cell was added.
+type columnConfig struct {
+	textStyle    TextStyle
+	numberFormat *NumberFormat
+	format       ColumnFormatFunc
+	priority     int
+}
+
+// columnConfig returns the settings for a column, counting from 0, creating
+// them if needed.
+func (t *Table) columnConfig(i int) *columnConfig {
+	if t.columnConfigs == nil {
+		t.columnConfigs = map[int]*columnConfig{}
+	}
+	cc, ok := t.columnConfigs[i]
+	if !ok {
+		cc = &columnConfig{}
+		t.columnConfigs[i] = cc
+	}
+	return cc
 }
 
 // EnableUTF8 will unconditionally enable using UTF-8 box-drawing characters
@@ -87,6 +144,21 @@ func EnableUTF8PerLocale() {
 	}
 }
 
+// isEastAsianLocale reports whether a locale name is for Chinese, Japanese or
+// Korean, where terminals draw ambiguous-width characters wide, unless the
+// "@cjk_narrow" modifier asks otherwise.
+func isEastAsianLocale(locale string) bool {
+	if strings.HasSuffix(locale, "@cjk_narrow") {
+		return false
+	}
+	for _, lang := range []string{"ja", "ko", "zh"} {
+		if strings.HasPrefix(locale, lang) {
+			return true
+		}
+	}
+	return false
+}
+
 // getLocale returns the current locale name.
 func getLocale() string {
 	if runtime.GOOS == "windows" {
@@ -107,6 +179,16 @@ func unixLocale() string {
 	return "US-ASCII"
 }
 
+// SetColorDepth overrides the colour depth used for terminal output by any
+// tables created after this call.  By default this is detected at program
+// initialization from whether stdout is a terminal and from environment
+// variables including $NO_COLOR, $TERM and $COLORTERM; colours are
+// downgraded to the nearest available, and with term.NoColor all SGR
+// sequences, including any embedded in cell content, are removed.
+func SetColorDepth(depth term.ColorDepth) {
+	outputsEnabled.colorDepth = depth
+}
+
 // SetHTMLStyleTitle lets an HTML title output mode be chosen.
 func SetHTMLStyleTitle(want titleStyle) {
 	outputsEnabled.titleStyle = want
@@ -133,6 +215,7 @@ func init() {
 	if err == nil && sz.Columns != 0 {
 		MaxColumns = sz.Columns
 	}
+	outputsEnabled.colorDepth = term.GetColorDepth(os.Stdout)
 }
 
 // CreateTable creates an empty Table using defaults for style.
@@ -145,9 +228,17 @@ func CreateTable() *Table {
 		t.Style.htmlRules.title = outputsEnabled.titleStyle
 	}
 	t.outputMode = defaultOutputMode
+	t.colorDepth = outputsEnabled.colorDepth
 	return t
 }
 
+// columnHeaderRow returns the row of column headers for the table.
+func (t *Table) columnHeaderRow() *Row {
+	row := createHeaderRow(t.headers)
+	row.upperCase = t.Style.UpperCaseHeaders
+	return row
+}
+
 // AddSeparator adds a line to the table content, where the line
 // consists of separator characters.
 func (t *Table) AddSeparator() {
@@ -176,7 +267,7 @@ func (t *Table) AddHeaders(headers ...interface{}) {
 // alignments are stored with each cell, so cells added after a call to
 // SetAlign will not pick up the change.  Columns are numbered from 1.
 func (t *Table) SetAlign(align tableAlignment, column int) {
-	if column < 0 {
+	if column < 1 {
 		return
 	}
 	for i := range t.elements {
@@ -184,17 +275,95 @@ func (t *Table) SetAlign(align tableAlignment, column int) {
 		if !ok {
 			continue
 		}
-		if column >= len(row.cells) {
+		if column > len(row.cells) {
 			continue
 		}
 		row.cells[column-1].alignment = &align
 	}
 }
 
+// SetColumnStyle sets the TextStyle for the content of a column of the
+// table.  Unlike SetAlign, this applies to all data cells in the column,
+// whether added before or after the call; headers are not affected.  Row and
+// cell styles are layered on top of the column style.  Columns are numbered
+// from 1.
+func (t *Table) SetColumnStyle(column int, style TextStyle) {
+	if column < 1 {
+		return
+	}
+	t.columnConfig(column - 1).textStyle = style
+}
+
+// SetNumberFormat sets the format used for numbers in the data rows of the
+// table, in all output modes; it applies to rows added before or after.
+func (t *Table) SetNumberFormat(format NumberFormat) {
+	t.numberFormat = &format
+}
+
+// SetColumnNumberFormat sets the format used for numbers in one column
+// (counting from 1) of the data rows of the table, overriding any format set
+// with SetNumberFormat.
+func (t *Table) SetColumnNumberFormat(column int, format NumberFormat) {
+	if column < 1 {
+		return
+	}
+	t.columnConfig(column - 1).numberFormat = &format
+}
+
+// SetColumnFormat sets the function used to draw the values in one column
+// (counting from 1) of the data rows of the table, such as FormatBytesIEC;
+// it takes precedence over any NumberFormat, but not over a Format given in
+// the CellStyle of a cell.
+func (t *Table) SetColumnFormat(column int, format FormatFunc) {
+	if column < 1 {
+		return
+	}
+	if format == nil {
+		t.columnConfig(column - 1).format = nil
+		return
+	}
+	t.columnConfig(column - 1).format = func(v interface{}, _, _ int) (string, TextStyle) {
+		return format(v), TextStyle{}
+	}
+}
+
+// SetColumnFormatFunc sets the function used to draw the values in one
+// column (counting from 1) of the data rows of the table, as SetColumnFormat
+// does, but with a function given the position of each cell and able to
+// style it.
+func (t *Table) SetColumnFormatFunc(column int, format ColumnFormatFunc) {
+	if column < 1 {
+		return
+	}
+	t.columnConfig(column - 1).format = format
+}
+
+// RegisterFormatter sets the Formatter used for values of type typ in this
+// table, in place of any registered for every table with the package-level
+// RegisterFormatter, which describes how typ is matched.  It applies to rows
+// added before or after, including the headers.
+func (t *Table) RegisterFormatter(typ reflect.Type, f Formatter) {
+	if t.formatters == nil {
+		t.formatters = &formatterRegistry{}
+	}
+	t.formatters.register(typ, f)
+}
+
+// SetPlainStyle sets the table style to a copy of PlainStyle, for output
+// without borders.
+func (t *Table) SetPlainStyle() {
+	style := *PlainStyle
+	t.Style = &style
+}
+
 // UTF8Box sets the table style to use UTF-8 box-drawing characters,
-// overriding all relevant style elements at the time of the call.
+// overriding all relevant style elements at the time of the call.  The
+// table is given its own copy of the style, so that a shared one such as
+// DefaultStyle is unchanged.
 func (t *Table) UTF8Box() {
-	t.Style.setUtfBoxStyle()
+	style := *t.Style
+	style.setUtfBoxStyle()
+	t.Style = &style
 }
 
 // SetModeHTML switches this table to be in HTML when rendered; the
@@ -215,6 +384,19 @@ func (t *Table) SetModeTerminal() {
 	t.outputMode = outputTerminal
 }
 
+// SetColorDepth overrides the colour depth used when rendering this table
+// for a terminal.
+func (t *Table) SetColorDepth(depth term.ColorDepth) {
+	t.colorDepth = depth
+}
+
+// SetSanitizePolicy sets how the content of cells in this table is cleaned
+// up when rendered, which matters where that content comes from untrusted
+// sources; see SanitizePolicy.
+func (t *Table) SetSanitizePolicy(policy SanitizePolicy) {
+	t.sanitize = policy
+}
+
 // SetHTMLStyleTitle lets an HTML output mode be chosen; we should rework this
 // into a more generic and extensible API as we clean up termtables.
 func (t *Table) SetHTMLStyleTitle(want titleStyle) {
@@ -228,7 +410,20 @@ func (t *Table) Render() string {
 	// Elements is already populated with row data.
 	switch t.outputMode {
 	case outputTerminal:
-		return t.renderTerminal()
+		if t.expanded == ExpandedOn {
+			return t.renderExpanded()
+		}
+		tt, note := t.dropColumns()
+		out := tt.renderTerminal()
+		if tt.maxLineWidth(out) > MaxColumns {
+			switch {
+			case tt.splitKeys != nil:
+				out = tt.renderSplit()
+			case tt.expanded == ExpandedAuto:
+				out = tt.renderExpanded()
+			}
+		}
+		return out + note
 	case outputMarkdown:
 		return t.renderMarkdown()
 	case outputHTML:
@@ -244,6 +439,10 @@ func (t *Table) renderTerminal() string {
 	// Use a placeholder rather than adding titles/headers to the tables
 	// elements or else successive calls will compound them.
 	tt := t.clone()
+	tt.elements = tt.limitedElements()
+	if footer := t.footerRow(); footer != nil {
+		tt.elements = append(tt.elements, &Separator{}, footer)
+	}
 
 	// Initial top line.
 	if !tt.Style.SkipBorder {
@@ -259,7 +458,9 @@ func (t *Table) renderTerminal() string {
 	// If we have headers, include them.
 	if tt.headers != nil {
 		ne := make([]Element, 2)
-		ne[1] = CreateRow(tt.headers)
+		headerRow := tt.columnHeaderRow()
+		headerRow.SetStyle(tt.Style.HeaderTextStyle)
+		ne[1] = headerRow
 		if tt.title != nil {
 			ne[0] = &Separator{where: LINE_SUBTOP}
 		} else {
@@ -271,14 +472,26 @@ func (t *Table) renderTerminal() string {
 	// If we have a title, write it.
 	if tt.title != nil {
 		// Match changes to this into renderMarkdown too.
-		tt.titleCell = CreateCell(tt.title, &CellStyle{Alignment: AlignCenter, ColSpan: 999})
+		titleCell := CreateCell(tt.title, &CellStyle{Alignment: AlignCenter, ColSpan: fullWidthSpan})
 		ne := []Element{
 			&StraightSeparator{where: LINE_TOP},
-			CreateRow([]interface{}{tt.titleCell}),
+			createHeaderRow([]interface{}{titleCell}),
 		}
 		tt.elements = append(ne, tt.elements...)
 	}
 
+	if tt.Style.SkipHorizontalBorders {
+		elements := tt.elements[:0]
+		for _, e := range tt.elements {
+			switch e.(type) {
+			case *Separator, *StraightSeparator:
+			default:
+				elements = append(elements, e)
+			}
+		}
+		tt.elements = elements
+	}
+
 	// Create a new table from the
 	// generate the runtime style. Must include all cells being printed.
 	style := createRenderStyle(tt)
@@ -291,7 +504,7 @@ func (t *Table) renderTerminal() string {
 	}
 
 	// Add bottom line.
-	if !style.SkipBorder {
+	if !style.SkipBorder && !style.SkipHorizontalBorders {
 		b.WriteString((&Separator{where: LINE_BOTTOM}).Render(style) + "\n")
 	}
 
@@ -307,7 +520,18 @@ func (t *Table) renderMarkdown() string {
 	// tables as markdown is ignored in there.  Do need to do _something_
 	// with a '|' character shown as a member of a table.
 
-	t.Style.setAsciiBoxStyle()
+	// As with renderTerminal, work on a copy so that successive calls do not
+	// compound the header lines added below; the ASCII borders go on a copy
+	// of the style too, so that the table keeps its own for other modes.
+	footer := t.footerRow()
+	t = t.clone()
+	ascii := *t.Style
+	ascii.setAsciiBoxStyle()
+	t.Style = &ascii
+	t.elements = t.limitedElements()
+	if footer != nil {
+		t.elements = append(t.elements, footer)
+	}
 
 	firstLines := make([]Element, 0, 2)
 
@@ -321,14 +545,15 @@ func (t *Table) renderMarkdown() string {
 		}
 	}
 
-	firstLines = append(firstLines, CreateRow(t.headers))
+	firstLines = append(firstLines, t.columnHeaderRow())
 	// This is a dummy line, swapped out below.
 	firstLines = append(firstLines, firstLines[0])
 	t.elements = append(firstLines, t.elements...)
 	// Generate the runtime style.
 	style := createRenderStyle(t)
-	// We know that the second line is a dummy, we can replace it.
-	mdRow := CreateRow([]interface{}{})
+	// We know that the second line is a dummy, we can replace it; as with
+	// the headers, column settings must not apply to it.
+	mdRow := createHeaderRow([]interface{}{})
 	for i := 0; i < style.columns; i++ {
 		mdRow.AddCell(CreateCell(strings.Repeat("-", style.cellWidths[i]), &CellStyle{}))
 	}
@@ -358,11 +583,30 @@ func (t *Table) renderMarkdown() string {
 // clone returns a copy of the table with the underlying slices being copied;
 // the references to the Elements/cells are left as shallow copies.
 func (t *Table) clone() *Table {
-	tt := &Table{outputMode: t.outputMode, Style: t.Style, title: t.title}
+	tt := &Table{
+		outputMode:    t.outputMode,
+		colorDepth:    t.colorDepth,
+		sanitize:      t.sanitize,
+		numberFormat:  t.numberFormat,
+		formatters:    t.formatters,
+		Style:         t.Style,
+		title:         t.title,
+		columnConfigs: t.columnConfigs,
+		rowLimit:      t.rowLimit,
+		expanded:      t.expanded,
+		splitKeys:     t.splitKeys,
+
+		suppressColumn:    t.suppressColumn,
+		hiddenColumnsNote: t.hiddenColumnsNote,
+	}
 	if t.headers != nil {
 		tt.headers = make([]interface{}, len(t.headers))
 		copy(tt.headers, t.headers)
 	}
+	if t.footers != nil {
+		tt.footers = make([]interface{}, len(t.footers))
+		copy(tt.footers, t.footers)
+	}
 	if t.elements != nil {
 		tt.elements = make([]Element, len(t.elements))
 		copy(tt.elements, t.elements)
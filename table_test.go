@@ -1,7 +1,11 @@
 // Copyright 2012-2013 Apcera Inc. All rights reserved.
 package termtables
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/apcera/termtables/term"
+)
 
 func DisplayFailedOutput(actual, expected string) string {
 	return "Output didn't match expected\n\n" +
@@ -18,6 +22,15 @@ func checkRendersTo(t *testing.T, table *Table, expected string) {
 	}
 }
 
+// narrowAmbiguous gives a table its own copy of its style, drawing East Asian
+// ambiguous characters narrow, for tests whose expected output would
+// otherwise depend upon the locale they are run in.
+func narrowAmbiguous(table *Table) {
+	style := *table.Style
+	style.AmbiguousWidth = AmbiguousNarrow
+	table.Style = &style
+}
+
 func TestCreateTable(t *testing.T) {
 	expected := "" +
 		"+-----------+-------+\n" +
@@ -191,6 +204,7 @@ func TestTableUnicodeWidths(t *testing.T) {
 		"+-----------+------+\n"
 
 	table := CreateTable()
+	narrowAmbiguous(table)
 	table.AddHeaders("Name", "Cost")
 	table.AddRow("Currency", "¤10")
 	table.AddRow("US Dollar", "$30")
@@ -216,6 +230,7 @@ func TestTableInUTF8(t *testing.T) {
 
 	table := CreateTable()
 	table.UTF8Box()
+	narrowAmbiguous(table)
 
 	table.AddTitle("Example")
 	table.AddHeaders("Name", "Value")
@@ -228,6 +243,56 @@ func TestTableInUTF8(t *testing.T) {
 	checkRendersTo(t, table, expected)
 }
 
+func TestTableAmbiguousWidth(t *testing.T) {
+	expectedNarrow := "" +
+		"╭─────────────╮\n" +
+		"│   ΑΒΓΔΕΖ    │\n" +
+		"├──────┬──────┤\n" +
+		"│ α    │ 1    │\n" +
+		"│ βγδε │ 2345 │\n" +
+		"╰──────┴──────╯\n"
+	// Every character but the digits and spaces is two cells wide here.
+	expectedWide := "" +
+		"╭─────────╮\n" +
+		"│   ΑΒΓΔΕΖ   │\n" +
+		"├─────┬───┤\n" +
+		"│ α       │ 1    │\n" +
+		"│ βγδε │ 2345 │\n" +
+		"╰─────┴───╯\n"
+
+	table := CreateTable()
+	table.Style = &TableStyle{PaddingLeft: 1, PaddingRight: 1, Alignment: AlignLeft}
+	table.UTF8Box()
+	table.AddTitle("ΑΒΓΔΕΖ")
+	table.AddRow("α", 1)
+	table.AddRow("βγδε", 2345)
+
+	table.Style.AmbiguousWidth = AmbiguousNarrow
+	checkRendersTo(t, table, expectedNarrow)
+
+	table.Style.AmbiguousWidth = AmbiguousWide
+	checkRendersTo(t, table, expectedWide)
+}
+
+func TestEastAsianLocale(t *testing.T) {
+	tests := []struct {
+		locale string
+		wide   bool
+	}{
+		{"en_US.UTF-8", false},
+		{"C", false},
+		{"ja_JP.UTF-8", true},
+		{"zh_CN.GB18030", true},
+		{"ko_KR.EUC-KR", true},
+		{"ja_JP.UTF-8@cjk_narrow", false},
+	}
+	for _, test := range tests {
+		if got := isEastAsianLocale(test.locale); got != test.wide {
+			t.Errorf("Unexpected result for locale %q; expected %v but got %v", test.locale, test.wide, got)
+		}
+	}
+}
+
 func TestTableUnicodeUTF8AndSGR(t *testing.T) {
 	// at present, this mostly just tests that alignment still works
 	expected := "" +
@@ -249,7 +314,9 @@ func TestTableUnicodeUTF8AndSGR(t *testing.T) {
 	bold := func(in string) string { return sgred(in, "1") }
 
 	table := CreateTable()
+	table.SetColorDepth(term.TrueColor)
 	table.UTF8Box()
+	narrowAmbiguous(table)
 
 	table.AddTitle(bold("Fanciness"))
 	table.AddHeaders(sgred("red", "31"), sgred("green", "32"))
@@ -304,6 +371,7 @@ func TestTitleUnicodeWidths(t *testing.T) {
 	// one space of padding, and non-empty tables.
 
 	table := CreateTable()
+	narrowAmbiguous(table)
 
 	// We have 4 characters down for left and right columns and padding, so
 	// a width of 5 for us should match the minimum per the columns
@@ -402,6 +470,28 @@ func TestTableAlignPostsetting(t *testing.T) {
 	checkRendersTo(t, table, expected)
 }
 
+func TestTableSetAlignColumns(t *testing.T) {
+	expected := "" +
+		"+-------+-------+\n" +
+		"| Name  | Value |\n" +
+		"+-------+-------+\n" +
+		"| hey   |   you |\n" +
+		"| derek |  3.14 |\n" +
+		"+-------+-------+\n"
+
+	table := CreateTable()
+
+	table.AddHeaders("Name", "Value")
+	table.AddRow("hey", "you")
+	table.AddRow("derek", 3.14)
+
+	table.SetAlign(AlignRight, 0)
+	table.SetAlign(AlignRight, 2)
+	table.SetAlign(AlignRight, 3)
+
+	checkRendersTo(t, table, expected)
+}
+
 func TestTableMissingCells(t *testing.T) {
 	expected := "" +
 		"+----------+---------+---------+\n" +
@@ -423,10 +513,8 @@ func TestTableMissingCells(t *testing.T) {
 	checkRendersTo(t, table, expected)
 }
 
-// We don't yet support combining characters, double-width characters or
-// anything to do with estimating a tty-style "character width" for what in
-// Unicode is a grapheme cluster.  This disabled test shows what we want
-// to support, but don't yet.
+// Widths are measured per grapheme cluster, so a combining character takes
+// no space of its own.
 func TestTableWithCombiningChars(t *testing.T) {
 	expected := "" +
 		"+------+---+\n" +
@@ -444,6 +532,31 @@ func TestTableWithCombiningChars(t *testing.T) {
 	checkRendersTo(t, table, expected)
 }
 
+func TestTableWithEmojiSequences(t *testing.T) {
+	expected := "" +
+		"+-------------+--------+\n" +
+		"| Sequence    | Emoji  |\n" +
+		"+-------------+--------+\n" +
+		"| family      | \U0001f468\u200d\U0001f469\u200d\U0001f467     |\n" +
+		"| skin tone   | \U0001f44d\U0001f3fd     |\n" +
+		"| flags       | \U0001f1fa\U0001f1f8\U0001f1ec\U0001f1e7   |\n" +
+		"| keycaps     | 1\ufe0f\u20e32\ufe0f\u20e3#\ufe0f\u20e3 |\n" +
+		"| emoji style | \u2764\ufe0f     |\n" +
+		"| text style  | \u2764      |\n" +
+		"+-------------+--------+\n"
+
+	table := CreateTable()
+	table.AddHeaders("Sequence", "Emoji")
+	table.AddRow("family", "\U0001f468\u200d\U0001f469\u200d\U0001f467")
+	table.AddRow("skin tone", "\U0001f44d\U0001f3fd")
+	table.AddRow("flags", "\U0001f1fa\U0001f1f8\U0001f1ec\U0001f1e7")
+	table.AddRow("keycaps", "1\ufe0f\u20e32\ufe0f\u20e3#\ufe0f\u20e3")
+	table.AddRow("emoji style", "\u2764\ufe0f")
+	table.AddRow("text style", "\u2764")
+
+	checkRendersTo(t, table, expected)
+}
+
 // another unicode length issue
 func TestTableWithFullwidthChars(t *testing.T) {
 	expected := "" +
@@ -560,3 +673,71 @@ func BenchmarkTableRenderHTML(b *testing.B) {
 		table.Render()
 	}
 }
+
+func TestTablePlainStyle(t *testing.T) {
+	expected := "" +
+		"HOST  REQUESTS  LATENCY\n" +
+		"web1  1200      30ms\n" +
+		"web2  800       50ms\n" +
+		"web3  2500      10ms\n" +
+		"all hosts       5\n"
+
+	table := createFooterTable()
+	table.SetPlainStyle()
+	table.AddSeparator()
+	table.AddRow(CreateCell("all hosts", &CellStyle{ColSpan: 2}), 5)
+	checkRendersTo(t, table, expected)
+
+	if PlainStyle.Gutter != "  " || table.Style == PlainStyle {
+		t.Fatal("SetPlainStyle should not share PlainStyle")
+	}
+}
+
+func TestTablePlainStyleTrimsStyledPadding(t *testing.T) {
+	expected := "" +
+		"NAME  STATE\n" +
+		"a     \033[31mdown\033[0m\n" +
+		"bb    \033[31mup\033[0m\n"
+
+	table := CreateTable()
+	table.SetColorDepth(term.TrueColor)
+	table.SetPlainStyle()
+	table.AddHeaders("Name", "State")
+	table.AddRow("a", "down")
+	table.AddRow("bb", "up  ")
+	table.SetColumnStyle(2, TextStyle{Foreground: ColorRed})
+	checkRendersTo(t, table, expected)
+}
+
+func TestTableSkipVerticalBorders(t *testing.T) {
+	expected := "" +
+		"-------------------------\n" +
+		"          Hosts\n" +
+		"-------------------------\n" +
+		"HOST : REQUESTS : LATENCY\n" +
+		"-------------------------\n" +
+		"web1 : 1200     : 30ms\n" +
+		"web2 : 800      : 50ms\n" +
+		"web3 : 2500     : 10ms\n" +
+		"-------------------------\n"
+
+	table := createFooterTable()
+	table.AddTitle("Hosts")
+	table.SetPlainStyle()
+	table.Style.SkipHorizontalBorders = false
+	table.Style.Gutter = " : "
+	checkRendersTo(t, table, expected)
+
+	expected = "" +
+		"Table: Hosts\n" +
+		"\n" +
+		"| HOST | REQUESTS | LATENCY |\n" +
+		"| ---- | -------- | ------- |\n" +
+		"| web1 | 1200     | 30ms    |\n" +
+		"| web2 | 800      | 50ms    |\n" +
+		"| web3 | 2500     | 10ms    |\n"
+
+	table.Style.PaddingLeft, table.Style.PaddingRight = 1, 1
+	table.SetModeMarkdown()
+	checkRendersTo(t, table, expected)
+}
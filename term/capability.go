@@ -0,0 +1,83 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package term
+
+import (
+	"os"
+	"runtime"
+	"strings"
+)
+
+// ColorDepth describes how many colours a terminal can display.
+type ColorDepth int
+
+// These constants are the colour depths which we distinguish between, in
+// increasing order of capability.
+const (
+	// NoColor means that no SGR colour or attribute sequences should be
+	// emitted at all.
+	NoColor ColorDepth = iota
+
+	// Colors16 is the eight basic colours and their bright variants.
+	Colors16
+
+	// Colors256 is the xterm 256-colour palette.
+	Colors256
+
+	// TrueColor is 24-bit RGB colour.
+	TrueColor
+)
+
+// IsTerminal reports whether file is a terminal, as judged by whether the
+// OS will give us a window size for it.
+func IsTerminal(file *os.File) bool {
+	_, err := GetTerminalWindowSize(file)
+	return err == nil
+}
+
+// GetEnvColorDepth returns the colour depth as determined by process
+// environment, assuming that output is to a terminal.  A non-empty $NO_COLOR
+// or a $TERM of "dumb" disable colour; $COLORTERM of "truecolor" or "24bit"
+// indicates 24-bit colour, as do $TERM values ending in "-direct" or
+// "-truecolor", while a $TERM ending in "-256color" indicates the 256-colour
+// palette.  Any other $TERM is taken to support the sixteen basic colours.
+func GetEnvColorDepth() ColorDepth {
+	if os.Getenv("NO_COLOR") != "" {
+		return NoColor
+	}
+
+	term := os.Getenv("TERM")
+	if term == "dumb" {
+		return NoColor
+	}
+
+	switch os.Getenv("COLORTERM") {
+	case "truecolor", "24bit":
+		return TrueColor
+	}
+
+	switch {
+	case strings.HasSuffix(term, "-direct"), strings.HasSuffix(term, "-truecolor"):
+		return TrueColor
+	case strings.HasSuffix(term, "-256color"):
+		return Colors256
+	case term != "":
+		return Colors16
+	}
+
+	// The Windows console does not set $TERM but does handle colour.
+	if runtime.GOOS == "windows" {
+		return Colors16
+	}
+	return NoColor
+}
+
+// GetColorDepth returns the colour depth to use when writing to file: if
+// file is not a terminal then this is NoColor, otherwise it is as given by
+// GetEnvColorDepth.
+func GetColorDepth(file *os.File) ColorDepth {
+	if !IsTerminal(file) {
+		return NoColor
+	}
+	return GetEnvColorDepth()
+}
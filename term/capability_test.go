@@ -0,0 +1,39 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package term
+
+import (
+	"os"
+	"testing"
+)
+
+func TestGetEnvColorDepth(t *testing.T) {
+	tests := []struct {
+		noColor, term, colorTerm string
+		depth                    ColorDepth
+	}{
+		{"", "xterm", "", Colors16},
+		{"", "xterm-256color", "", Colors256},
+		{"", "xterm-direct", "", TrueColor},
+		{"", "xterm-256color", "truecolor", TrueColor},
+		{"", "screen", "24bit", TrueColor},
+		{"", "dumb", "truecolor", NoColor},
+		{"1", "xterm-256color", "truecolor", NoColor},
+	}
+	for _, name := range []string{"NO_COLOR", "TERM", "COLORTERM"} {
+		if value, ok := os.LookupEnv(name); ok {
+			defer os.Setenv(name, value)
+		} else {
+			defer os.Unsetenv(name)
+		}
+	}
+	for _, test := range tests {
+		os.Setenv("NO_COLOR", test.noColor)
+		os.Setenv("TERM", test.term)
+		os.Setenv("COLORTERM", test.colorTerm)
+		if got := GetEnvColorDepth(); got != test.depth {
+			t.Errorf("Unexpected colour depth for NO_COLOR=%q TERM=%q COLORTERM=%q; expected %d but got %d",
+				test.noColor, test.term, test.colorTerm, test.depth, got)
+		}
+	}
+}
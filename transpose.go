@@ -0,0 +1,65 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+// Transpose returns a new Table in which each column of this one becomes a
+// row: the headers, if any, become the first column, each data row becomes
+// a column, in order, and the footer, if any, becomes the last column, with
+// its aggregates computed as the table now stands.  Cells keep their values,
+// alignments and styles, and the title and table-wide settings carry over,
+// but per-column settings, any row limit and the key columns given to
+// SetSplitColumns do not, as the columns they apply to are gone.  A cell
+// spanning several columns is placed in the first of the rows they become,
+// leaving the rest empty.  Separators, group headers and subtotals have no
+// place in the new table and are dropped.  The new table shares no mutable
+// state with this one.
+func (t *Table) Transpose() *Table {
+	tt := t.copySettings()
+	tt.columnConfigs = nil
+	tt.rowLimit.head, tt.rowLimit.tail = 0, 0
+	tt.splitKeys = nil
+	tt.suppressColumn = 0
+
+	columns := [][]*Cell{}
+	if t.headers != nil {
+		header := createHeaderRow(t.headers)
+		for _, c := range header.cells {
+			c.textStyle = t.Style.HeaderTextStyle.merge(c.textStyle)
+		}
+		columns = append(columns, header.cells)
+	}
+	for _, e := range t.elements {
+		if row, ok := e.(*Row); ok && row.isData() {
+			columns = append(columns, row.cells)
+		}
+	}
+	if footer := t.footerRow(); footer != nil {
+		columns = append(columns, footer.cells)
+	}
+
+	rows := make([]*Row, t.columnCount())
+	for i := range rows {
+		rows[i] = &Row{cells: []*Cell{}}
+	}
+	for _, cells := range columns {
+		position := 0
+		for _, c := range cells {
+			if position < len(rows) {
+				nc := c.copy()
+				nc.colSpan = 1
+				rows[position].AddCell(nc)
+			}
+			for span := 1; span < c.colSpan && position+span < len(rows); span++ {
+				rows[position+span].AddCell("")
+			}
+			position += c.colSpan
+		}
+		for ; position < len(rows); position++ {
+			rows[position].AddCell("")
+		}
+	}
+	for _, row := range rows {
+		tt.elements = append(tt.elements, row)
+	}
+	return tt
+}
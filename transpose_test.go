@@ -0,0 +1,42 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func TestTableTranspose(t *testing.T) {
+	expected := "" +
+		"+---------------------------------------------+\n" +
+		"|                    Hosts                    |\n" +
+		"+----------+------+------+------+-----+-------+\n" +
+		"| Host     | web1 | web2 | web3 | all | Total |\n" +
+		"| Requests | 1200 |  800 | 2500 |     | 4500  |\n" +
+		"| Latency  | 30ms | 50ms | 10ms | 5   |       |\n" +
+		"+----------+------+------+------+-----+-------+\n"
+
+	table := createFooterTable()
+	table.AddTitle("Hosts")
+	table.AddFooter("Total", AggregateSum)
+	table.SetAlign(AlignRight, 2)
+	table.AddSeparator()
+	table.AddRow(CreateCell("all", &CellStyle{ColSpan: 2}), 5)
+	checkRendersTo(t, table.Transpose(), expected)
+}
+
+func TestTableTransposeWithoutHeaders(t *testing.T) {
+	expected := "" +
+		"+---+----+---+\n" +
+		"| a | bb |   |\n" +
+		"| 1 | 22 | c |\n" +
+		"+---+----+---+\n"
+
+	table := CreateTable()
+	table.AddRow("a", 1)
+	table.AddRow("bb", 22)
+	table.GroupBy(1, nil)
+	table.AddRow("", "c")
+	table.SetRowLimit(1, 0)
+	checkRendersTo(t, table.Transpose(), expected)
+}
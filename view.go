@@ -0,0 +1,276 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+// Filter returns a new Table holding only the data rows of this one for
+// which keep returns true, with the same headers, footers, title and
+// settings; aggregates in the footer are computed over the rows kept.
+// Separators are kept between the rows which remain, but not where they
+// would start or end the table or follow another separator.  Rows added by
+// GroupBy are not passed to keep: a group header is kept if any row after
+// it in its group is, and a subtotal if any row before it in its group is,
+// though its value is not recomputed.  The new table shares no mutable
+// state with this one.
+func (t *Table) Filter(keep func(*Row) bool) *Table {
+	tt := t.copySettings()
+	var pending Element
+	headers := []Element{}
+	kept := false
+	for _, e := range t.elements {
+		row, ok := e.(*Row)
+		switch {
+		case !ok:
+			if len(tt.elements) > 0 {
+				pending = e
+			}
+			headers, kept = nil, false
+			continue
+		case row.header:
+			headers, kept = append(headers, row), false
+			continue
+		case !row.isData():
+			if kept {
+				tt.elements = append(tt.elements, row.copy())
+			}
+			continue
+		case !keep(row):
+			continue
+		}
+		if pending != nil {
+			tt.elements = append(tt.elements, copyElement(pending))
+			pending = nil
+		}
+		for _, h := range headers {
+			tt.elements = append(tt.elements, copyElement(h))
+		}
+		headers, kept = nil, true
+		tt.elements = append(tt.elements, row.copy())
+	}
+	if t.headers != nil {
+		tt.headers = append([]interface{}{}, t.headers...)
+	}
+	if t.footers != nil {
+		tt.footers = append([]interface{}{}, t.footers...)
+	}
+	return tt
+}
+
+// Select returns a new Table holding only the given columns of this one,
+// counting from 1, in the order given; a column can be given more than once.
+// Headers, footers, per-column settings and the alignment and style of each
+// cell go with their column.  A cell spanning several columns is kept once
+// for each run of them selected next to each other, in order, spanning that
+// run.  The new table shares no mutable state with this one.
+func (t *Table) Select(columns ...int) *Table {
+	tt := t.copySettings()
+	tt.columnConfigs = nil
+	for i, column := range columns {
+		if cc, ok := t.columnConfigs[column-1]; ok {
+			ncc := *cc
+			if tt.columnConfigs == nil {
+				tt.columnConfigs = map[int]*columnConfig{}
+			}
+			tt.columnConfigs[i] = &ncc
+		}
+	}
+
+	if t.splitKeys != nil {
+		tt.splitKeys = []int{}
+		for _, key := range t.splitKeys {
+			for i, column := range columns {
+				if column == key {
+					tt.splitKeys = append(tt.splitKeys, i+1)
+					break
+				}
+			}
+		}
+	}
+
+	tt.suppressColumn = 0
+	for i, column := range columns {
+		if column == t.suppressColumn {
+			tt.suppressColumn = i + 1
+			break
+		}
+	}
+
+	tt.headers = selectItems(t.headers, columns)
+	tt.footers = selectItems(t.footers, columns)
+
+	for _, e := range t.elements {
+		row, ok := e.(*Row)
+		if !ok {
+			tt.elements = append(tt.elements, copyElement(e))
+			continue
+		}
+		tt.elements = append(tt.elements, row.selectColumns(columns))
+	}
+	return tt
+}
+
+// Hide returns a new Table without one of the columns of this one, counting
+// from 1, as Select does with all the other columns.
+func (t *Table) Hide(column int) *Table {
+	columns := []int{}
+	for i := 1; i <= t.columnCount(); i++ {
+		if i != column {
+			columns = append(columns, i)
+		}
+	}
+	return t.Select(columns...)
+}
+
+// selectItems returns the headers or footers for the given columns, counting
+// from 1, or nil if there are none.
+func selectItems(items []interface{}, columns []int) []interface{} {
+	if items == nil {
+		return nil
+	}
+	selected := []interface{}{}
+	for _, column := range columns {
+		var item interface{} = ""
+		if column >= 1 && column <= len(items) {
+			item = items[column-1]
+		}
+		selected = append(selected, item)
+	}
+	return selected
+}
+
+// columnCount returns the number of columns in the table, taking into
+// account the headers and the columns which cells span.
+func (t *Table) columnCount() int {
+	n := len(t.headers)
+	for _, e := range t.elements {
+		if row, ok := e.(*Row); ok {
+			width := 0
+			for _, c := range row.cells {
+				if c.colSpan < fullWidthSpan {
+					width += c.colSpan
+				} else {
+					width++
+				}
+			}
+			if width > n {
+				n = width
+			}
+		}
+	}
+	return n
+}
+
+// copySettings returns a new Table with copies of the style, title and
+// settings of this one, but no rows or headers.
+func (t *Table) copySettings() *Table {
+	tt := &Table{
+		outputMode: t.outputMode,
+		colorDepth: t.colorDepth,
+		sanitize:   t.sanitize,
+		title:      t.title,
+		rowLimit:   t.rowLimit,
+		expanded:   t.expanded,
+
+		suppressColumn:    t.suppressColumn,
+		hiddenColumnsNote: t.hiddenColumnsNote,
+	}
+	if t.splitKeys != nil {
+		tt.splitKeys = append([]int{}, t.splitKeys...)
+	}
+	if t.Style != nil {
+		style := *t.Style
+		tt.Style = &style
+	}
+	if t.numberFormat != nil {
+		nf := *t.numberFormat
+		tt.numberFormat = &nf
+	}
+	if t.formatters != nil {
+		tt.formatters = t.formatters.copy()
+	}
+	if t.columnConfigs != nil {
+		tt.columnConfigs = make(map[int]*columnConfig, len(t.columnConfigs))
+		for i, cc := range t.columnConfigs {
+			ncc := *cc
+			tt.columnConfigs[i] = &ncc
+		}
+	}
+	return tt
+}
+
+// copy returns a deep copy of the registry.
+func (r *formatterRegistry) copy() *formatterRegistry {
+	rr := &formatterRegistry{nilValue: r.nilValue}
+	for typ, f := range r.exact {
+		rr.register(typ, f)
+	}
+	rr.interfaces = append(rr.interfaces, r.interfaces...)
+	return rr
+}
+
+// copyElement returns a copy of an element of a table.
+func copyElement(e Element) Element {
+	switch ee := e.(type) {
+	case *Row:
+		return ee.copy()
+	case *Separator:
+		s := *ee
+		return &s
+	case *StraightSeparator:
+		s := *ee
+		return &s
+	}
+	return e
+}
+
+// copy returns a copy of the row with copies of its cells.
+func (r *Row) copy() *Row {
+	rr := *r
+	rr.cells = make([]*Cell, len(r.cells))
+	for i, c := range r.cells {
+		rr.cells[i] = c.copy()
+	}
+	return &rr
+}
+
+// copy returns a copy of the cell, not sharing its alignment.
+func (c *Cell) copy() *Cell {
+	cc := *c
+	if c.alignment != nil {
+		align := *c.alignment
+		cc.alignment = &align
+	}
+	return &cc
+}
+
+// selectColumns returns a copy of the row holding the cells for the given
+// columns, counting from 1; columns the row has no cell for are left empty.
+// Columns are found by counting the span of each cell along the row.
+func (r *Row) selectColumns(columns []int) *Row {
+	rr := *r
+	rr.cells = []*Cell{}
+	for i := 0; i < len(columns); i++ {
+		column := columns[i] - 1
+		var cell *Cell
+		start := 0
+		for _, c := range r.cells {
+			if column >= start && column < start+c.colSpan {
+				cell = c
+				break
+			}
+			start += c.colSpan
+		}
+		if cell == nil {
+			rr.AddCell("")
+			continue
+		}
+
+		nc := cell.copy()
+		nc.colSpan = 1
+		for i+1 < len(columns) && columns[i+1] > columns[i] && columns[i+1]-1 < start+cell.colSpan {
+			nc.colSpan++
+			i++
+		}
+		rr.AddCell(nc)
+	}
+	return &rr
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Apcera Inc. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func createViewTable() *Table {
+	table := CreateTable()
+	table.AddHeaders("Name", "Kind", "Size")
+	table.AddRow("alpha", "file", 10)
+	table.AddRow("beta", "dir", 0)
+	table.AddSeparator()
+	table.AddRow("gamma", "file", 250)
+	table.AddSeparator()
+	table.AddRow("delta", "dir", 0)
+	table.SetAlign(AlignRight, 3)
+	return table
+}
+
+func TestTableFilter(t *testing.T) {
+	expected := "" +
+		"+-------+------+------+\n" +
+		"| Name  | Kind | Size |\n" +
+		"+-------+------+------+\n" +
+		"| alpha | file |   10 |\n" +
+		"+-------+------+------+\n" +
+		"| gamma | file |  250 |\n" +
+		"+-------+------+------+\n"
+
+	table := createViewTable()
+	files := table.Filter(func(r *Row) bool { return r.Value(2) == "file" })
+	checkRendersTo(t, files, expected)
+
+	expected = "" +
+		"+-------+------+------+\n" +
+		"| Name  | Kind | Size |\n" +
+		"+-------+------+------+\n" +
+		"| beta  | dir  |    0 |\n" +
+		"+-------+------+------+\n" +
+		"| delta | dir  |    0 |\n" +
+		"+-------+------+------+\n"
+
+	dirs := table.Filter(func(r *Row) bool { return r.Value(2) == "dir" })
+	checkRendersTo(t, dirs, expected)
+}
+
+func TestTableSelect(t *testing.T) {
+	expected := "" +
+		"+------+-------+\n" +
+		"| Size | Name  |\n" +
+		"+------+-------+\n" +
+		"|   10 | alpha |\n" +
+		"|    0 | beta  |\n" +
+		"+------+-------+\n" +
+		"|  250 | gamma |\n" +
+		"+------+-------+\n" +
+		"|    0 | delta |\n" +
+		"+------+-------+\n"
+
+	table := createViewTable()
+	table.SetColumnNumberFormat(3, NumberFormat{Precision: 0, ThousandsSeparator: ","})
+	view := table.Select(3, 1)
+	checkRendersTo(t, view, expected)
+
+	// Column settings move with the column, and are not left behind for the
+	// column which takes its old position.
+	expected = "" +
+		"+-------+-------+-------+\n" +
+		"| Size  | Name  | Count |\n" +
+		"+-------+-------+-------+\n" +
+		"| 2,500 | alpha | 1200  |\n" +
+		"| 40    | beta  | 3     |\n" +
+		"+-------+-------+-------+\n"
+
+	table = CreateTable()
+	table.AddHeaders("Name", "Count", "Size")
+	table.AddRow("alpha", 1200, 2500)
+	table.AddRow("beta", 3, 40)
+	table.SetColumnNumberFormat(3, NumberFormat{Precision: 0, ThousandsSeparator: ","})
+	checkRendersTo(t, table.Select(3, 1, 2), expected)
+}
+
+func TestTableSelectSpanningCell(t *testing.T) {
+	expected := "" +
+		"+---+--------+\n" +
+		"| A | C      |\n" +
+		"+---+--------+\n" +
+		"| 1 |      3 |\n" +
+		"| wide       |\n" +
+		"| x | merged |\n" +
+		"+---+--------+\n"
+
+	table := CreateTable()
+	table.AddHeaders("A", "B", "C")
+	table.AddRow(1, 2, 3)
+	table.AddRow(CreateCell("wide", &CellStyle{ColSpan: 3}))
+	table.AddRow("x", CreateCell("merged", &CellStyle{ColSpan: 2}))
+	original := table.Render()
+
+	view := table.Select(1, 3)
+	view.SetAlign(AlignRight, 2)
+	checkRendersTo(t, view, expected)
+
+	// Changes to the view leave the original table as it was.
+	view.AddRow("y", "added")
+	checkRendersTo(t, table, original)
+}
+
+func TestTableHide(t *testing.T) {
+	expected := "" +
+		"+-------+------+\n" +
+		"| Name  | Size |\n" +
+		"+-------+------+\n" +
+		"| alpha |   10 |\n" +
+		"| beta  |    0 |\n" +
+		"+-------+------+\n" +
+		"| gamma |  250 |\n" +
+		"+-------+------+\n" +
+		"| delta |    0 |\n" +
+		"+-------+------+\n"
+
+	table := createViewTable()
+	original := table.Render()
+	view := table.Hide(2)
+	checkRendersTo(t, view, expected)
+
+	// Changes to the alignment and style of the view leave the original
+	// table as it was.
+	view.SetAlign(AlignLeft, 2)
+	view.Style.PaddingLeft = 3
+	checkRendersTo(t, table, original)
+}